@@ -0,0 +1,156 @@
+// Package collectionstest provides a conformance suite that checks collections.Iterable
+// implementations against the collections.Iterator contract.
+package collectionstest
+
+import (
+	"testing"
+
+	"github.com/jorge-barroso/collections"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestIterable checks that iterators created by iterable follow the collections.Iterator
+// contract and visit exactly the elements of want, in the same order
+func TestIterable[T any](t *testing.T, iterable collections.Iterable[T], want []T) {
+	t.Helper()
+	testIterable(t, iterable, want, true)
+}
+
+// TestUnorderedIterable checks that iterators created by iterable follow the collections.Iterator
+// contract and visit exactly the elements of want, in any order
+func TestUnorderedIterable[T any](t *testing.T, iterable collections.Iterable[T], want []T) {
+	t.Helper()
+	testIterable(t, iterable, want, false)
+}
+
+func testIterable[T any](t *testing.T, iterable collections.Iterable[T], want []T, ordered bool) {
+	t.Run("ValueBeforeNext", func(t *testing.T) {
+		it := iterable.NewIterator()
+		_, err := it.Value()
+		assert.ErrorIs(t, err, collections.ErrNoSuchElement, "Value() before Next() should return ErrNoSuchElement")
+	})
+
+	t.Run("Traversal", func(t *testing.T) {
+		got := drain(t, iterable.NewIterator())
+		assertElements(t, want, got, ordered)
+	})
+
+	t.Run("ValueIsIdempotent", func(t *testing.T) {
+		it := iterable.NewIterator()
+		for it.Next() {
+			first, err := it.Value()
+			assert.NoError(t, err, "Unexpected error on the first call to Value()")
+			second, err := it.Value()
+			assert.NoError(t, err, "Unexpected error on the second call to Value()")
+			assert.Equal(t, first, second, "Value() must not move the iterator")
+		}
+	})
+
+	t.Run("Exhausted", func(t *testing.T) {
+		it := iterable.NewIterator()
+		var last T
+		for it.Next() {
+			last, _ = it.Value()
+		}
+
+		for i := 0; i < 3; i++ {
+			assert.False(t, it.Next(), "Next() should keep returning false once the iterator is exhausted")
+		}
+
+		value, err := it.Value()
+		if len(want) == 0 {
+			assert.ErrorIs(t, err, collections.ErrNoSuchElement, "Value() on an empty iterable should return ErrNoSuchElement")
+			return
+		}
+		assert.NoError(t, err, "Value() should keep returning the last element once the iterator is exhausted")
+		assert.Equal(t, last, value, "Value() should stay on the last element once the iterator is exhausted")
+	})
+
+	t.Run("IndependentIterators", func(t *testing.T) {
+		first := iterable.NewIterator()
+		second := iterable.NewIterator()
+
+		var gotFirst, gotSecond []T
+		for {
+			hasFirst := first.Next()
+			if hasFirst {
+				value, err := first.Value()
+				assert.NoError(t, err, "Unexpected error from the first iterator")
+				gotFirst = append(gotFirst, value)
+			}
+			hasSecond := second.Next()
+			if hasSecond {
+				value, err := second.Value()
+				assert.NoError(t, err, "Unexpected error from the second iterator")
+				gotSecond = append(gotSecond, value)
+			}
+			if !hasFirst && !hasSecond {
+				break
+			}
+		}
+
+		assertElements(t, want, gotFirst, ordered)
+		assertElements(t, want, gotSecond, ordered)
+	})
+}
+
+// drain collects every element an iterator visits
+func drain[T any](t *testing.T, it collections.Iterator[T]) []T {
+	var got []T
+	for it.Next() {
+		value, err := it.Value()
+		if !assert.NoError(t, err, "Unexpected error during iteration") {
+			break
+		}
+		got = append(got, value)
+	}
+	return got
+}
+
+func assertElements[T any](t *testing.T, want, got []T, ordered bool) {
+	if len(want) == 0 {
+		assert.Empty(t, got, "Iterator should not visit any element")
+		return
+	}
+	if ordered {
+		assert.Equal(t, want, got, "Iterator visited unexpected elements or in the wrong order")
+	} else {
+		assert.ElementsMatch(t, want, got, "Iterator visited unexpected elements")
+	}
+}
+
+// TestFailFastIterable checks that iterators created by iterable detect a structural modification
+// made by modify and report it through Value as collections.ErrConcurrentModification.
+// modify is called once per check and must change the structure of iterable, which must not be empty.
+func TestFailFastIterable[T any](t *testing.T, iterable collections.Iterable[T], modify func()) {
+	t.Helper()
+
+	t.Run("ModifiedWhilePositioned", func(t *testing.T) {
+		it := iterable.NewIterator()
+		assert.True(t, it.Next(), "Next() should return true for the first element")
+		_, err := it.Value()
+		assert.NoError(t, err, "Unexpected error before the modification")
+
+		modify()
+		assertFailed(t, it)
+	})
+
+	t.Run("ModifiedBeforeNext", func(t *testing.T) {
+		it := iterable.NewIterator()
+		modify()
+		assertFailed(t, it)
+	})
+}
+
+// assertFailed checks how an iterator behaves once it has detected a concurrent modification
+func assertFailed[T any](t *testing.T, it collections.Iterator[T]) {
+	_, err := it.Value()
+	assert.ErrorIs(t, err, collections.ErrConcurrentModification, "Value() should report the concurrent modification")
+
+	assert.True(t, it.Next(), "Next() should return true once so the modification can be reported")
+	_, err = it.Value()
+	assert.ErrorIs(t, err, collections.ErrConcurrentModification, "Value() should keep reporting the concurrent modification")
+
+	assert.False(t, it.Next(), "Next() should stop advancing after reporting the modification")
+	assert.False(t, it.Next(), "Next() should keep returning false after reporting the modification")
+}
@@ -0,0 +1,110 @@
+package collectionstest
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jorge-barroso/collections"
+)
+
+func TestIterable_Seq(t *testing.T) {
+	TestIterable(t, collections.FromSeq(slices.Values([]int{1, 2, 3})), []int{1, 2, 3})
+}
+
+func TestIterable_EmptySeq(t *testing.T) {
+	TestIterable(t, collections.FromSeq(slices.Values([]int(nil))), nil)
+}
+
+func TestUnorderedIterable_Seq(t *testing.T) {
+	TestUnorderedIterable(t, collections.FromSeq(slices.Values([]string{"b", "a"})), []string{"a", "b"})
+}
+
+// failFastSlice is a minimal fail-fast iterable used to exercise TestFailFastIterable
+type failFastSlice struct {
+	values   []int
+	modCount int
+}
+
+func (s *failFastSlice) NewIterator() collections.Iterator[int] {
+	return &failFastSliceIterator{slice: s, index: -1, expectedModCount: s.modCount}
+}
+
+type failFastSliceIterator struct {
+	slice            *failFastSlice
+	index            int
+	expectedModCount int
+	failed           bool
+}
+
+func (it *failFastSliceIterator) Next() bool {
+	if it.slice.modCount != it.expectedModCount {
+		if it.failed {
+			return false
+		}
+		it.failed = true
+		return true
+	}
+	if it.index < len(it.slice.values)-1 {
+		it.index++
+		return true
+	}
+	return false
+}
+
+func (it *failFastSliceIterator) Value() (int, error) {
+	if it.slice.modCount != it.expectedModCount {
+		return 0, collections.ErrConcurrentModification
+	}
+	if it.index < 0 {
+		return 0, collections.ErrNoSuchElement
+	}
+	return it.slice.values[it.index], nil
+}
+
+func TestFailFastIterable_Slice(t *testing.T) {
+	s := &failFastSlice{values: []int{1, 2, 3}}
+	TestIterable[int](t, s, []int{1, 2, 3})
+	TestFailFastIterable[int](t, s, func() {
+		s.values = append(s.values, 4)
+		s.modCount++
+	})
+}
+
+// sliceSpliterator is a minimal spliterator used to exercise TestSpliterator
+type sliceSpliterator struct {
+	values []int
+}
+
+func (s *sliceSpliterator) TryAdvance(action func(int)) bool {
+	if len(s.values) == 0 {
+		return false
+	}
+	action(s.values[0])
+	s.values = s.values[1:]
+	return true
+}
+
+func (s *sliceSpliterator) ForEachRemaining(action func(int)) {
+	for s.TryAdvance(action) {
+	}
+}
+
+func (s *sliceSpliterator) TrySplit() collections.Spliterator[int] {
+	mid := len(s.values) / 2
+	if mid == 0 {
+		return nil
+	}
+	prefix := &sliceSpliterator{values: s.values[:mid]}
+	s.values = s.values[mid:]
+	return prefix
+}
+
+func (s *sliceSpliterator) EstimateSize() int {
+	return len(s.values)
+}
+
+func TestSpliterator_Slice(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5, 6, 7}
+	TestSpliterator(t, func() collections.Spliterator[int] { return &sliceSpliterator{values: values} }, values, true)
+	TestSpliterator(t, func() collections.Spliterator[int] { return &sliceSpliterator{} }, nil, true)
+}
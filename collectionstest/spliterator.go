@@ -0,0 +1,58 @@
+package collectionstest
+
+import (
+	"testing"
+
+	"github.com/jorge-barroso/collections"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSpliterator checks that spliterators returned by newSpliterator visit exactly the elements
+// of want, and that splitting them recursively neither loses, duplicates nor, when ordered is set,
+// reorders any element. newSpliterator is called once per check.
+func TestSpliterator[T any](t *testing.T, newSpliterator func() collections.Spliterator[T], want []T, ordered bool) {
+	t.Helper()
+
+	t.Run("TryAdvance", func(t *testing.T) {
+		s := newSpliterator()
+		var got []T
+		for s.TryAdvance(func(value T) { got = append(got, value) }) {
+		}
+		assertElements(t, want, got, ordered)
+		assert.False(t, s.TryAdvance(func(T) {}), "TryAdvance() should keep returning false once exhausted")
+	})
+
+	t.Run("ForEachRemaining", func(t *testing.T) {
+		s := newSpliterator()
+		var got []T
+		if len(want) > 0 {
+			assert.True(t, s.TryAdvance(func(value T) { got = append(got, value) }), "TryAdvance() should find the first element")
+		}
+		s.ForEachRemaining(func(value T) { got = append(got, value) })
+		assertElements(t, want, got, ordered)
+	})
+
+	t.Run("RecursiveSplit", func(t *testing.T) {
+		got := splitAndCollect(newSpliterator())
+		assertElements(t, want, got, ordered)
+	})
+
+	t.Run("SplitAfterAdvance", func(t *testing.T) {
+		s := newSpliterator()
+		var got []T
+		s.TryAdvance(func(value T) { got = append(got, value) })
+		got = append(got, splitAndCollect(s)...)
+		assertElements(t, want, got, ordered)
+	})
+}
+
+// splitAndCollect splits s as far as it goes and collects the parts in encounter order
+func splitAndCollect[T any](s collections.Spliterator[T]) []T {
+	var got []T
+	if prefix := s.TrySplit(); prefix != nil {
+		got = append(got, splitAndCollect(prefix)...)
+		return append(got, splitAndCollect(s)...)
+	}
+	s.ForEachRemaining(func(value T) { got = append(got, value) })
+	return got
+}
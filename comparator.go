@@ -0,0 +1,65 @@
+package collections
+
+import "cmp"
+
+// Comparator orders two values, returning a negative number when a comes before b,
+// a positive number when a comes after b and zero when they are equivalent
+type Comparator[T any] func(a, b T) int
+
+// NaturalOrder returns a Comparator ordering values from the smallest to the largest
+func NaturalOrder[T cmp.Ordered]() Comparator[T] {
+	return cmp.Compare[T]
+}
+
+// ReverseOrder returns a Comparator ordering values from the largest to the smallest
+func ReverseOrder[T cmp.Ordered]() Comparator[T] {
+	return func(a, b T) int {
+		return cmp.Compare(b, a)
+	}
+}
+
+// Comparing returns a Comparator ordering values by the natural order of the key extracted from them
+func Comparing[T any, K cmp.Ordered](key func(T) K) Comparator[T] {
+	return func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	}
+}
+
+// ComparingWith returns a Comparator ordering values by the key extracted from them, ordered by keyOrder
+func ComparingWith[T any, K any](key func(T) K, keyOrder Comparator[K]) Comparator[T] {
+	return func(a, b T) int {
+		return keyOrder(key(a), key(b))
+	}
+}
+
+// Reversed returns a Comparator imposing the opposite order of c
+func (c Comparator[T]) Reversed() Comparator[T] {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+// ThenComparing returns a Comparator ordering values by c, falling back on next for values c finds equivalent
+func (c Comparator[T]) ThenComparing(next Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		if order := c(a, b); order != 0 {
+			return order
+		}
+		return next(a, b)
+	}
+}
+
+// FromLess returns a Comparator consistent with less, which must report whether a strictly precedes b.
+// It calls less up to twice per comparison, so a three-way comparison should be preferred when available.
+func FromLess[T any](less func(a, b T) bool) Comparator[T] {
+	return func(a, b T) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		default:
+			return 0
+		}
+	}
+}
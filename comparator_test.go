@@ -0,0 +1,60 @@
+package collections
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNaturalAndReverseOrder(t *testing.T) {
+	values := []int{3, 1, 2}
+
+	slices.SortFunc(values, NaturalOrder[int]())
+	assert.Equal(t, []int{1, 2, 3}, values, "NaturalOrder should sort from the smallest value")
+
+	slices.SortFunc(values, ReverseOrder[int]())
+	assert.Equal(t, []int{3, 2, 1}, values, "ReverseOrder should sort from the largest value")
+}
+
+func TestComparing(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+	}
+	people := []person{{"carol", 41}, {"alice", 29}, {"bob", 35}}
+
+	slices.SortFunc(people, Comparing(func(p person) int { return p.age }))
+	assert.Equal(t, []person{{"alice", 29}, {"bob", 35}, {"carol", 41}}, people, "Comparing should sort by the extracted key")
+
+	byName := Comparing(func(p person) string { return p.name })
+	assert.Negative(t, byName(people[0], people[1]), "alice should come before bob")
+	assert.Zero(t, byName(people[2], people[2]), "Equal keys should compare as equivalent")
+}
+
+func TestComparator_Combinators(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+	}
+	people := []person{{"bob", 35}, {"carol", 29}, {"alice", 35}, {"dave", 29}}
+
+	byAgeThenName := Comparing(func(p person) int { return p.age }).
+		ThenComparing(Comparing(func(p person) string { return p.name }))
+	slices.SortFunc(people, byAgeThenName)
+	assert.Equal(t, []person{{"carol", 29}, {"dave", 29}, {"alice", 35}, {"bob", 35}}, people, "ThenComparing should break ties with the second comparator")
+
+	slices.SortFunc(people, byAgeThenName.Reversed())
+	assert.Equal(t, []person{{"bob", 35}, {"alice", 35}, {"dave", 29}, {"carol", 29}}, people, "Reversed should invert the whole order")
+
+	byNameLength := ComparingWith(func(p person) string { return p.name }, Comparing(func(s string) int { return len(s) }))
+	assert.Negative(t, byNameLength(person{name: "bob"}, person{name: "carol"}), "Shorter names should come first")
+	assert.Zero(t, byNameLength(person{name: "bob"}, person{name: "eve"}), "Names of equal length should be equivalent")
+}
+
+func TestFromLess(t *testing.T) {
+	compare := FromLess(func(a, b int) bool { return a < b })
+	assert.Negative(t, compare(1, 2), "1 should come before 2")
+	assert.Positive(t, compare(2, 1), "2 should come after 1")
+	assert.Zero(t, compare(2, 2), "Equal values should be equivalent")
+}
@@ -0,0 +1,86 @@
+package hashing
+
+import (
+	"hash/maphash"
+	"math"
+)
+
+// Type tags hashed ahead of the value, so that equal bits of different types do not collide
+// when K is an interface type holding values of several dynamic types
+const (
+	tagString byte = iota + 1
+	tagBool
+	tagInt
+	tagInt8
+	tagInt16
+	tagInt32
+	tagInt64
+	tagUint
+	tagUint8
+	tagUint16
+	tagUint32
+	tagUint64
+	tagUintptr
+	tagFloat32
+	tagFloat64
+	tagFallback // The maphash.Comparable hash of a key of any other type
+)
+
+// basicKey decomposes keys of the basic types into a type tag and either their bits or, for strings,
+// their text, so that hash functions can process them without allocating. It reports false for any other key.
+func basicKey[K comparable](key K) (tag byte, bits uint64, text string, ok bool) {
+	switch k := any(key).(type) {
+	case string:
+		return tagString, 0, k, true
+	case bool:
+		if k {
+			return tagBool, 1, "", true
+		}
+		return tagBool, 0, "", true
+	case int:
+		return tagInt, uint64(k), "", true
+	case int8:
+		return tagInt8, uint64(k), "", true
+	case int16:
+		return tagInt16, uint64(k), "", true
+	case int32:
+		return tagInt32, uint64(k), "", true
+	case int64:
+		return tagInt64, uint64(k), "", true
+	case uint:
+		return tagUint, uint64(k), "", true
+	case uint8:
+		return tagUint8, uint64(k), "", true
+	case uint16:
+		return tagUint16, uint64(k), "", true
+	case uint32:
+		return tagUint32, uint64(k), "", true
+	case uint64:
+		return tagUint64, k, "", true
+	case uintptr:
+		return tagUintptr, uint64(k), "", true
+	case float32:
+		if k == 0 {
+			k = 0 // -0 == +0, so both must hash the same
+		}
+		return tagFloat32, uint64(math.Float32bits(k)), "", true
+	case float64:
+		if k == 0 {
+			k = 0 // -0 == +0, so both must hash the same
+		}
+		return tagFloat64, math.Float64bits(k), "", true
+	default:
+		return 0, 0, "", false
+	}
+}
+
+// seedableKey is basicKey for seeded hash functions: keys of any other type are decomposed into their
+// maphash.Comparable hash under fallbackSeed, so that the seed still applies when that hash is hashed in turn.
+// Their final hashes are only stable within a process.
+func seedableKey[K comparable](key K) (tag byte, bits uint64, text string) {
+	tag, bits, text, ok := basicKey(key)
+	if !ok {
+		return tagFallback, maphash.Comparable(fallbackSeed, key), ""
+	}
+	return tag, bits, text
+}
@@ -0,0 +1,45 @@
+package hashing
+
+import "math"
+
+// ConsistentHash assigns keys to a set of weighted members so that adding or removing a member
+// only moves the keys it gains or loses, unlike hash % n which reassigns nearly every key.
+// Assignments only agree across processes when the hash function of the keys does.
+// Implementations are not safe for concurrent modification.
+type ConsistentHash[K comparable] interface {
+	// Add adds a member that receives a share of the keys proportional to its weight, or updates its weight
+	Add(member string, weight int) error
+	// Remove removes a member, its keys are spread over the remaining ones
+	Remove(member string) error
+	// Members returns the members in ascending order
+	Members() []string
+	// Locate returns the member a key is assigned to, or false if there are no members
+	Locate(key K) (string, bool)
+	// LocateN returns up to n distinct members for a key in order of preference, the first one being Locate(key)
+	LocateN(key K, n int) []string
+}
+
+// memberHash hashes a member name, or one of its virtual nodes, the same way in every process
+func memberHash(member string, node uint64) uint64 {
+	var digest XXHash64Digest
+	digest.reset(0)
+	_, _ = digest.WriteString(member)
+	digest.WriteUint64(node)
+	return digest.Sum64()
+}
+
+// mix64 scrambles the bits of a hash so that hashes differing in a few bits end up unrelated
+func mix64(hash uint64) uint64 {
+	return murmur128Finalize(hash)
+}
+
+// unitInterval maps a hash to a float in the open interval (0, 1)
+func unitInterval(hash uint64) float64 {
+	return (float64(hash>>11) + 0.5) / (1 << 53)
+}
+
+// rendezvousScore ranks a member for a key, the member with the highest score owns the key.
+// Weighting follows the logarithmic method, so that each member wins a share of keys proportional to its weight.
+func rendezvousScore(keyHash, memberHash uint64, weight int) float64 {
+	return float64(weight) / -math.Log(unitInterval(mix64(HashCombine(memberHash, keyHash))))
+}
@@ -0,0 +1,11 @@
+package hashing
+
+import "errors"
+
+var (
+	// errInvalidWeight is returned when adding a member with a weight below 1
+	errInvalidWeight = errors.New("member weight must be at least 1")
+
+	// errUnknownMember is returned when removing a member that was never added
+	errUnknownMember = errors.New("member not found")
+)
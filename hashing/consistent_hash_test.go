@@ -0,0 +1,169 @@
+package hashing
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// consistentHashes lists every ConsistentHash implementation so their shared behaviour is checked once
+var consistentHashes = map[string]func() ConsistentHash[string]{
+	"HashRing":       func() ConsistentHash[string] { return NewHashRing[string](NewFNVHash[string](), DefaultVirtualNodes) },
+	"RendezvousHash": func() ConsistentHash[string] { return NewRendezvousHash[string](NewFNVHash[string]()) },
+	"JumpHash":       func() ConsistentHash[string] { return NewJumpHash[string](NewFNVHash[string]()) },
+}
+
+// forEachConsistentHash runs the test against every implementation after adding the given members with weight 1
+func forEachConsistentHash(t *testing.T, members []string, test func(t *testing.T, c ConsistentHash[string])) {
+	for name, newConsistentHash := range consistentHashes {
+		t.Run(name, func(t *testing.T) {
+			c := newConsistentHash()
+			for _, member := range members {
+				assert.NoError(t, c.Add(member, 1), "Unexpected error when adding member %s", member)
+			}
+			test(t, c)
+		})
+	}
+}
+
+// testKeys returns n distinct keys
+func testKeys(n int) []string {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("key-%d", i)
+	}
+	return keys
+}
+
+// assignments locates every key
+func assignments(c ConsistentHash[string], keys []string) map[string]string {
+	owners := make(map[string]string, len(keys))
+	for _, key := range keys {
+		owners[key], _ = c.Locate(key)
+	}
+	return owners
+}
+
+func TestConsistentHash_Empty(t *testing.T) {
+	forEachConsistentHash(t, nil, func(t *testing.T, c ConsistentHash[string]) {
+		_, ok := c.Locate("key")
+		assert.False(t, ok, "Nothing should be located without members")
+		assert.Empty(t, c.LocateN("key", 3), "Nothing should be located without members")
+		assert.Empty(t, c.Members(), "Expected no members")
+	})
+}
+
+func TestConsistentHash_MembersAndErrors(t *testing.T) {
+	forEachConsistentHash(t, []string{"c", "a", "b"}, func(t *testing.T, c ConsistentHash[string]) {
+		assert.Equal(t, []string{"a", "b", "c"}, c.Members(), "Members should be sorted")
+		assert.Error(t, c.Add("d", 0), "A weight below 1 should be rejected")
+		assert.Error(t, c.Remove("d"), "Removing an unknown member should fail")
+
+		assert.NoError(t, c.Remove("b"), "Unexpected error when removing member b")
+		assert.Equal(t, []string{"a", "c"}, c.Members(), "Removed members should be gone")
+
+		assert.NoError(t, c.Add("a", 2), "Unexpected error when updating the weight of member a")
+		assert.Equal(t, []string{"a", "c"}, c.Members(), "Updating a weight should not duplicate the member")
+	})
+}
+
+func TestConsistentHash_LocateN(t *testing.T) {
+	forEachConsistentHash(t, []string{"a", "b", "c", "d"}, func(t *testing.T, c ConsistentHash[string]) {
+		for _, key := range testKeys(100) {
+			owner, ok := c.Locate(key)
+			assert.True(t, ok, "Expected %s to be located", key)
+
+			replicas := c.LocateN(key, 3)
+			assert.Len(t, replicas, 3, "Expected 3 replicas for %s", key)
+			assert.Equal(t, owner, replicas[0], "The first replica should be the owner of %s", key)
+			assert.ElementsMatch(t, []string{"a", "b", "c", "d"}, c.LocateN(key, 10), "Asking for too many replicas should return every member once")
+			assert.Empty(t, c.LocateN(key, 0), "Asking for no replicas should return none")
+		}
+	})
+}
+
+func TestConsistentHash_AddingMovesKeysToTheNewMemberOnly(t *testing.T) {
+	keys := testKeys(2000)
+	forEachConsistentHash(t, []string{"a", "b", "c"}, func(t *testing.T, c ConsistentHash[string]) {
+		before := assignments(c, keys)
+		assert.NoError(t, c.Add("d", 1), "Unexpected error when adding member d")
+		after := assignments(c, keys)
+
+		moved := 0
+		for _, key := range keys {
+			if before[key] != after[key] {
+				moved++
+				assert.Equal(t, "d", after[key], "Key %s should only move to the new member", key)
+			}
+		}
+		assert.InDelta(t, len(keys)/4, moved, float64(len(keys))/10, "About a quarter of the keys should move")
+
+		assert.NoError(t, c.Remove("d"), "Unexpected error when removing member d")
+		assert.Equal(t, before, assignments(c, keys), "Removing the new member should restore the previous assignments")
+	})
+}
+
+func TestConsistentHash_RemovingKeepsOtherKeys(t *testing.T) {
+	keys := testKeys(2000)
+	for _, name := range []string{"HashRing", "RendezvousHash"} {
+		t.Run(name, func(t *testing.T) {
+			c := consistentHashes[name]()
+			for _, member := range []string{"a", "b", "c", "d"} {
+				assert.NoError(t, c.Add(member, 1), "Unexpected error when adding member %s", member)
+			}
+
+			before := assignments(c, keys)
+			assert.NoError(t, c.Remove("b"), "Unexpected error when removing member b")
+			after := assignments(c, keys)
+			for _, key := range keys {
+				if before[key] != "b" {
+					assert.Equal(t, before[key], after[key], "Key %s did not belong to the removed member and should stay", key)
+				} else {
+					assert.NotEqual(t, "b", after[key], "Key %s should have left the removed member", key)
+				}
+			}
+		})
+	}
+}
+
+func TestConsistentHash_Weights(t *testing.T) {
+	keys := testKeys(8000)
+	forEachConsistentHash(t, []string{"a", "b"}, func(t *testing.T, c ConsistentHash[string]) {
+		assert.NoError(t, c.Add("heavy", 2), "Unexpected error when adding a weighted member")
+
+		counts := make(map[string]int)
+		for _, owner := range assignments(c, keys) {
+			counts[owner]++
+		}
+		assert.InDelta(t, len(keys)/4, counts["a"], float64(len(keys))/16, "Member a should get about a quarter of the keys")
+		assert.InDelta(t, len(keys)/4, counts["b"], float64(len(keys))/16, "Member b should get about a quarter of the keys")
+		assert.InDelta(t, len(keys)/2, counts["heavy"], float64(len(keys))/16, "A member of weight 2 should get about half of the keys")
+	})
+}
+
+func TestJumpHash_ReferenceBuckets(t *testing.T) {
+	vectors := []struct {
+		key      uint64
+		buckets  int
+		expected int
+	}{
+		{1, 1, 0},
+		{42, 57, 43},
+		{0xdead10cc, 1, 0},
+		{0xdead10cc, 666, 361},
+		{256, 1024, 520},
+	}
+	for _, vector := range vectors {
+		assert.Equal(t, vector.expected, jumpBucket(vector.key, vector.buckets), "Unexpected bucket for key %d out of %d", vector.key, vector.buckets)
+	}
+}
+
+func TestHashRing_VirtualNodes(t *testing.T) {
+	ring := NewHashRing[string](NewFNVHash[string](), 0)
+	assert.NoError(t, ring.Add("a", 3), "Unexpected error when adding member a")
+	assert.Len(t, ring.points, 3, "Each unit of weight should place at least one virtual node")
+
+	assert.NoError(t, ring.Add("a", 1), "Unexpected error when updating member a")
+	assert.Len(t, ring.points, 1, "Updating the weight should replace the virtual nodes")
+}
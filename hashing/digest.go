@@ -0,0 +1,33 @@
+package hashing
+
+import "hash"
+
+// Digest is a streaming 64-bit hash computation, fed with byte slices, strings and integers
+// one piece at a time so that composite keys can be hashed field by field without building a buffer
+type Digest interface {
+	hash.Hash64
+	// WriteString appends the bytes of s to the input, it never fails
+	WriteString(s string) (int, error)
+	// WriteByte appends a single byte to the input, it never fails
+	WriteByte(b byte) error
+	// WriteUint64 appends the little endian bytes of value to the input
+	WriteUint64(value uint64)
+}
+
+// byteSeq lets the block functions of the hash algorithms read either byte slices or strings
+type byteSeq interface {
+	~[]byte | ~string
+}
+
+// readUint32 decodes the first 4 bytes of p as a little endian integer
+func readUint32[S byteSeq](p S) uint32 {
+	_ = p[3]
+	return uint32(p[0]) | uint32(p[1])<<8 | uint32(p[2])<<16 | uint32(p[3])<<24
+}
+
+// readUint64 decodes the first 8 bytes of p as a little endian integer
+func readUint64[S byteSeq](p S) uint64 {
+	_ = p[7]
+	return uint64(p[0]) | uint64(p[1])<<8 | uint64(p[2])<<16 | uint64(p[3])<<24 |
+		uint64(p[4])<<32 | uint64(p[5])<<40 | uint64(p[6])<<48 | uint64(p[7])<<56
+}
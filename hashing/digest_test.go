@@ -0,0 +1,103 @@
+package hashing
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// digests creates a fresh digest of every streaming algorithm
+var digests = map[string]func() Digest{
+	"XXHash64":    func() Digest { return NewXXHash64Digest(7) },
+	"Murmur3_32":  func() Digest { return NewMurmur3Digest32(7) },
+	"Murmur3_128": func() Digest { return NewMurmur3Digest128(7) },
+	"WyHash":      func() Digest { return NewWyHashDigest(7) },
+}
+
+func TestDigest_IncrementalWrites(t *testing.T) {
+	input := make([]byte, 200)
+	for i := range input {
+		input[i] = byte(i * 31)
+	}
+
+	for name, newDigest := range digests {
+		t.Run(name, func(t *testing.T) {
+			for length := 0; length <= len(input); length += 7 {
+				whole := newDigest()
+				_, _ = whole.Write(input[:length])
+				expected := whole.Sum64()
+
+				for split := 0; split <= length; split++ {
+					pieces := newDigest()
+					_, _ = pieces.Write(input[:split])
+					_, _ = pieces.WriteString(string(input[split:length]))
+					assert.Equal(t, expected, pieces.Sum64(), "Splitting %d bytes at %d should not change the hash", length, split)
+				}
+
+				bytewise := newDigest()
+				for _, b := range input[:length] {
+					_ = bytewise.WriteByte(b)
+				}
+				assert.Equal(t, expected, bytewise.Sum64(), "Writing %d bytes one at a time should not change the hash", length)
+			}
+		})
+	}
+}
+
+func TestDigest_CompositeKeys(t *testing.T) {
+	for name, newDigest := range digests {
+		t.Run(name, func(t *testing.T) {
+			fields := newDigest()
+			_, _ = fields.WriteString("user")
+			fields.WriteUint64(42)
+
+			var buf [8]byte
+			binary.LittleEndian.PutUint64(buf[:], 42)
+			bytes := newDigest()
+			_, _ = bytes.Write(append([]byte("user"), buf[:]...))
+			assert.Equal(t, bytes.Sum64(), fields.Sum64(), "Writing fields should match writing their bytes")
+
+			other := newDigest()
+			_, _ = other.WriteString("user")
+			other.WriteUint64(43)
+			assert.NotEqual(t, fields.Sum64(), other.Sum64(), "Different fields should hash differently")
+		})
+	}
+}
+
+func TestDigest_SumAndReset(t *testing.T) {
+	for name, newDigest := range digests {
+		t.Run(name, func(t *testing.T) {
+			digest := newDigest()
+			empty := digest.Sum64()
+
+			_, _ = digest.WriteString("some input")
+			first := digest.Sum64()
+			assert.Equal(t, first, digest.Sum64(), "Sum64 should not change the state")
+			assert.Len(t, digest.Sum(nil), digest.Size(), "Sum should append Size bytes")
+
+			digest.Reset()
+			assert.Equal(t, empty, digest.Sum64(), "Reset should discard the input but keep the seed")
+		})
+	}
+}
+
+func TestSeededHashFunctions_SeedStructKeys(t *testing.T) {
+	type point struct{ x, y int }
+	hashFunctions := map[string]func(seed uint64) HashFunction[point]{
+		"XXHash64":    func(seed uint64) HashFunction[point] { return NewXXHash64[point](seed) },
+		"Murmur3_32":  func(seed uint64) HashFunction[point] { return NewMurmur3Hash32[point](uint32(seed)) },
+		"Murmur3_128": func(seed uint64) HashFunction[point] { return NewMurmur3Hash128[point](seed) },
+		"WyHash":      func(seed uint64) HashFunction[point] { return NewWyHash[point](seed) },
+	}
+
+	for name, newHashFunction := range hashFunctions {
+		t.Run(name, func(t *testing.T) {
+			key := point{1, 2}
+			assert.Equal(t, newHashFunction(1).Hash(key), newHashFunction(1).Hash(key), "Equal seeds should agree on struct keys")
+			assert.NotEqual(t, newHashFunction(1).Hash(key), newHashFunction(2).Hash(key), "The seed should change the hashes of struct keys")
+			assert.NotEqual(t, newHashFunction(1).Hash(key), newHashFunction(1).Hash(point{2, 1}), "Different struct keys should hash differently")
+		})
+	}
+}
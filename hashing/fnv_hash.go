@@ -1,14 +1,20 @@
 package hashing
 
-import (
-	"fmt"
-	"hash/fnv"
+import "hash/maphash"
+
+const (
+	// fnvOffset64 and fnvPrime64 are the 64-bit FNV-1a parameters
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
 )
 
+// fallbackSeed seeds the hash of the keys that have no fast path, it is chosen once per process
+var fallbackSeed = maphash.MakeSeed()
+
 // FNVHash implements the FNV-1a hash algorithm
 type FNVHash[K comparable] struct{}
 
-// Ensure LinkedList implements both Map and Iterable interfaces
+// Ensure FNVHash implements HashFunction
 var _ HashFunction[int] = (*FNVHash[int])(nil)
 
 // NewFNVHash creates a new FNV hash function
@@ -16,20 +22,43 @@ func NewFNVHash[K comparable]() *FNVHash[K] {
 	return &FNVHash[K]{}
 }
 
-// Hash computes the FNV-1a hash of the key
+// Hash computes the FNV-1a hash of the key without allocating.
+// Strings, booleans, integers and floats are hashed directly along with a tag for their type,
+// any other key falls back on maphash.Comparable, whose results are only stable within a process.
+// Keys that are equal according to == always get the same hash.
 func (f *FNVHash[K]) Hash(key K) uint64 {
-	h := fnv.New64a()
+	tag, bits, text, ok := basicKey(key)
+	if !ok {
+		return maphash.Comparable(fallbackSeed, key)
+	}
 
-	// Include type information in the hashing process
-	typeInfo := fmt.Sprintf("%T", key) // Get the type as a string
-	if _, err := h.Write([]byte(typeInfo)); err != nil {
-		return 0
+	hash := fnvByte(fnvOffset64, tag)
+	if tag == tagString {
+		return fnvString(hash, text)
 	}
+	return fnvUint64(hash, bits)
+}
 
-	// Append the value of the key
-	if _, err := h.Write([]byte(fmt.Sprintf("%v", key))); err != nil {
-		return 0
+// fnvByte mixes a single byte into an FNV-1a hash
+func fnvByte(hash uint64, b byte) uint64 {
+	hash ^= uint64(b)
+	hash *= fnvPrime64
+	return hash
+}
+
+// fnvString mixes every byte of s into an FNV-1a hash
+func fnvString(hash uint64, s string) uint64 {
+	for i := 0; i < len(s); i++ {
+		hash = fnvByte(hash, s[i])
 	}
+	return hash
+}
 
-	return h.Sum64()
+// fnvUint64 mixes the little endian bytes of value into an FNV-1a hash
+func fnvUint64(hash, value uint64) uint64 {
+	for i := 0; i < 8; i++ {
+		hash = fnvByte(hash, byte(value))
+		value >>= 8
+	}
+	return hash
 }
@@ -1,6 +1,7 @@
 package hashing
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -78,3 +79,63 @@ func TestFNVHash_DifferentTypes(t *testing.T) {
 
 	assert.NotEqual(t, intHash, stringHash)
 }
+
+func TestFNVHash_Deterministic(t *testing.T) {
+	// Fast path hashes do not depend on the process, so they can be pinned
+	assert.Equal(t, uint64(0xaf63dc4c8601ec8c), fnvString(fnvOffset64, "a"), "FNV-1a mismatch for a reference input")
+	assert.Equal(t, NewFNVHash[string]().Hash("key"), (&FNVHash[string]{}).Hash("key"), "Hashers of the same type should agree")
+}
+
+func TestFNVHash_SignedZero(t *testing.T) {
+	negativeZero := math.Copysign(0, -1)
+	assert.Equal(t, (&FNVHash[float64]{}).Hash(0), (&FNVHash[float64]{}).Hash(negativeZero), "-0 and +0 are equal so they must hash the same")
+	assert.Equal(t, (&FNVHash[float32]{}).Hash(0), (&FNVHash[float32]{}).Hash(float32(negativeZero)), "-0 and +0 are equal so they must hash the same")
+	assert.NotEqual(t, (&FNVHash[float64]{}).Hash(0), (&FNVHash[float64]{}).Hash(1), "Different floats should not collide")
+}
+
+func TestFNVHash_InterfaceKeysKeepTheirType(t *testing.T) {
+	hasher := FNVHash[any]{}
+	assert.NotEqual(t, hasher.Hash(1), hasher.Hash(int64(1)), "Equal bits of different types should not collide")
+	assert.NotEqual(t, hasher.Hash(uint8(1)), hasher.Hash(true), "Equal bits of different types should not collide")
+	assert.NotEqual(t, hasher.Hash("1"), hasher.Hash(1), "Strings and integers should not collide")
+	assert.Equal(t, (&FNVHash[int]{}).Hash(7), hasher.Hash(7), "Interface keys should hash like their dynamic value")
+}
+
+func TestFNVHash_ConsistentWithEquality(t *testing.T) {
+	type point struct {
+		X, Y int
+		Name string
+	}
+	structHasher := FNVHash[point]{}
+	assert.Equal(t, structHasher.Hash(point{1, 2, "a"}), structHasher.Hash(point{1, 2, "a"}), "Equal structs must hash the same")
+	assert.NotEqual(t, structHasher.Hash(point{1, 2, "a"}), structHasher.Hash(point{2, 1, "a"}), "Different structs should not collide")
+
+	// Distinct pointers to equal values are not equal, even though they print the same
+	first, second := &point{1, 2, "a"}, &point{1, 2, "a"}
+	pointerHasher := FNVHash[*point]{}
+	assert.Equal(t, pointerHasher.Hash(first), pointerHasher.Hash(first), "The same pointer must hash the same")
+	assert.NotEqual(t, pointerHasher.Hash(first), pointerHasher.Hash(second), "Distinct pointers should not collide")
+}
+
+func TestFNVHash_DoesNotAllocate(t *testing.T) {
+	type compound struct {
+		ID   int64
+		Name string
+	}
+	key := compound{ID: 42, Name: "answer"}
+	text := "a reasonably long string key"
+
+	checks := map[string]func(){
+		"int":     func() { (&FNVHash[int]{}).Hash(123456789) },
+		"uint64":  func() { (&FNVHash[uint64]{}).Hash(math.MaxUint64) },
+		"float64": func() { (&FNVHash[float64]{}).Hash(math.Pi) },
+		"bool":    func() { (&FNVHash[bool]{}).Hash(true) },
+		"byte":    func() { (&FNVHash[byte]{}).Hash(200) },
+		"string":  func() { (&FNVHash[string]{}).Hash(text) },
+		"struct":  func() { (&FNVHash[compound]{}).Hash(key) },
+		"pointer": func() { (&FNVHash[*compound]{}).Hash(&key) },
+	}
+	for name, check := range checks {
+		assert.Zero(t, testing.AllocsPerRun(100, check), "Hashing a %s key should not allocate", name)
+	}
+}
@@ -5,3 +5,11 @@ type HashFunction[K comparable] interface {
 	// Hash computes a hash value for the given key
 	Hash(key K) uint64
 }
+
+// SeededHashFunction is a HashFunction whose results depend on a secret seed chosen per instance,
+// so that whoever controls the keys cannot predict their hashes and force collisions
+type SeededHashFunction[K comparable] interface {
+	HashFunction[K]
+	// Reseed returns a hash function of the same kind keyed with a fresh random seed
+	Reseed() SeededHashFunction[K]
+}
@@ -0,0 +1,116 @@
+package hashing
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"sort"
+)
+
+// DefaultVirtualNodes is the number of points each unit of weight places on a HashRing unless told otherwise
+const DefaultVirtualNodes = 160
+
+// HashRing implements consistent hashing on a ring: each member owns the arcs ending at its virtual nodes,
+// and a key belongs to the first virtual node found clockwise from its hash
+type HashRing[K comparable] struct {
+	hashFunc     HashFunction[K]
+	virtualNodes int            // Virtual nodes per unit of weight
+	weights      map[string]int // Weight of each member
+	points       []ringPoint    // Virtual nodes of every member, sorted by hash
+}
+
+// ringPoint is a virtual node of a member on the ring
+type ringPoint struct {
+	hash   uint64
+	member string
+}
+
+// Ensure HashRing implements ConsistentHash
+var _ ConsistentHash[string] = (*HashRing[string])(nil)
+
+// NewHashRing creates an empty ring hashing keys with hashFunc and placing virtualNodes points on it
+// per unit of weight of each member, more points spread the keys more evenly at the cost of memory
+func NewHashRing[K comparable](hashFunc HashFunction[K], virtualNodes int) *HashRing[K] {
+	return &HashRing[K]{
+		hashFunc:     hashFunc,
+		virtualNodes: max(virtualNodes, 1),
+		weights:      make(map[string]int),
+	}
+}
+
+// Add adds a member with weight times the virtual nodes of the ring, or updates its weight
+func (r *HashRing[K]) Add(member string, weight int) error {
+	if weight < 1 {
+		return errInvalidWeight
+	}
+	r.weights[member] = weight
+	r.rebuild()
+	return nil
+}
+
+// Remove removes a member along with its virtual nodes
+func (r *HashRing[K]) Remove(member string) error {
+	if _, ok := r.weights[member]; !ok {
+		return errUnknownMember
+	}
+	delete(r.weights, member)
+	r.rebuild()
+	return nil
+}
+
+// Members returns the members in ascending order
+func (r *HashRing[K]) Members() []string {
+	return slices.Sorted(maps.Keys(r.weights))
+}
+
+// Locate returns the member owning the first virtual node clockwise from the hash of the key
+func (r *HashRing[K]) Locate(key K) (string, bool) {
+	if len(r.points) == 0 {
+		return "", false
+	}
+	return r.points[r.search(key)].member, true
+}
+
+// LocateN walks clockwise from the hash of the key and returns the first n distinct members it meets
+func (r *HashRing[K]) LocateN(key K, n int) []string {
+	n = min(n, len(r.weights))
+	if n <= 0 {
+		return nil
+	}
+
+	members := make([]string, 0, n)
+	start := r.search(key)
+	for i := 0; len(members) < n; i++ {
+		member := r.points[(start+i)%len(r.points)].member
+		if !slices.Contains(members, member) {
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+// search returns the position of the first virtual node clockwise from the hash of the key
+func (r *HashRing[K]) search(key K) int {
+	hash := r.hashFunc.Hash(key)
+	i := sort.Search(len(r.points), func(i int) bool { return r.points[i].hash >= hash })
+	if i == len(r.points) {
+		return 0 // Wrap around past the last virtual node
+	}
+	return i
+}
+
+// rebuild places the virtual nodes of every member on the ring again
+func (r *HashRing[K]) rebuild() {
+	points := r.points[:0]
+	for member, weight := range r.weights {
+		for node := 0; node < weight*r.virtualNodes; node++ {
+			points = append(points, ringPoint{hash: memberHash(member, uint64(node)), member: member})
+		}
+	}
+
+	// Ties between the nodes of different members are broken by name so that every process agrees
+	slices.SortFunc(points, func(a, b ringPoint) int {
+		return cmp.Or(cmp.Compare(a.hash, b.hash), cmp.Compare(a.member, b.member))
+	})
+	r.points = points
+}
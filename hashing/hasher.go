@@ -0,0 +1,127 @@
+package hashing
+
+import "slices"
+
+// Hasher hashes and compares keys, so that keys which are not comparable with ==, or whose equality
+// is not ==, can be stored in hash maps. Keys that are Equal must have the same Hash.
+type Hasher[K any] interface {
+	// Hash computes a hash value for the given key
+	Hash(key K) uint64
+	// Equal reports whether two keys are the same key
+	Equal(a, b K) bool
+}
+
+// comparableHasher adapts a HashFunction to the Hasher interface, comparing keys with ==
+type comparableHasher[K comparable] struct {
+	HashFunction[K]
+}
+
+// NewComparableHasher creates a Hasher that hashes keys with hashFunc and compares them with ==
+func NewComparableHasher[K comparable](hashFunc HashFunction[K]) Hasher[K] {
+	return comparableHasher[K]{HashFunction: hashFunc}
+}
+
+// Equal reports whether a == b
+func (h comparableHasher[K]) Equal(a, b K) bool {
+	return a == b
+}
+
+// funcHasher builds a Hasher out of a pair of functions
+type funcHasher[K any] struct {
+	hash  func(key K) uint64
+	equal func(a, b K) bool
+}
+
+// NewFuncHasher creates a Hasher out of a hash and an equality function, which must agree with each other
+func NewFuncHasher[K any](hash func(key K) uint64, equal func(a, b K) bool) Hasher[K] {
+	return funcHasher[K]{hash: hash, equal: equal}
+}
+
+// Hash computes the hash of the key with the hash function
+func (h funcHasher[K]) Hash(key K) uint64 {
+	return h.hash(key)
+}
+
+// Equal compares the keys with the equality function
+func (h funcHasher[K]) Equal(a, b K) bool {
+	return h.equal(a, b)
+}
+
+// HashCombine mixes hash into seed, the result depends on the order the hashes are combined in
+func HashCombine(seed, hash uint64) uint64 {
+	return seed ^ (hash + 0x9e3779b97f4a7c15 + seed<<6 + seed>>2)
+}
+
+// sliceHasher hashes and compares slices element by element
+type sliceHasher[E any] struct {
+	elements Hasher[E]
+}
+
+// HashSlice creates a Hasher for slices out of a Hasher for their elements.
+// Slices are equal when they have equal elements in the same order, so nil and empty slices are equal.
+func HashSlice[E any](elements Hasher[E]) Hasher[[]E] {
+	return sliceHasher[E]{elements: elements}
+}
+
+// Hash combines the hashes of the elements of the slice in order
+func (h sliceHasher[E]) Hash(key []E) uint64 {
+	hash := uint64(len(key))
+	for _, element := range key {
+		hash = HashCombine(hash, h.elements.Hash(element))
+	}
+	return hash
+}
+
+// Equal reports whether both slices have equal elements in the same order
+func (h sliceHasher[E]) Equal(a, b []E) bool {
+	return slices.EqualFunc(a, b, h.elements.Equal)
+}
+
+// fieldHasher hashes and compares keys by one of their components
+type fieldHasher[K, F any] struct {
+	field  func(key K) F
+	hasher Hasher[F]
+}
+
+// HashField creates a Hasher for keys that only looks at the component extracted by field, see HashTuple
+func HashField[K, F any](field func(key K) F, hasher Hasher[F]) Hasher[K] {
+	return fieldHasher[K, F]{field: field, hasher: hasher}
+}
+
+// Hash computes the hash of the component of the key
+func (h fieldHasher[K, F]) Hash(key K) uint64 {
+	return h.hasher.Hash(h.field(key))
+}
+
+// Equal compares the components of both keys
+func (h fieldHasher[K, F]) Equal(a, b K) bool {
+	return h.hasher.Equal(h.field(a), h.field(b))
+}
+
+// tupleHasher hashes and compares composite keys component by component
+type tupleHasher[K any] []Hasher[K]
+
+// HashTuple creates a Hasher for composite keys out of a Hasher for each of their components,
+// usually built with HashField. Keys are equal when all their components are.
+func HashTuple[K any](components ...Hasher[K]) Hasher[K] {
+	return tupleHasher[K](slices.Clone(components))
+}
+
+// Hash combines the hashes of the components of the key in order
+func (h tupleHasher[K]) Hash(key K) uint64 {
+	hash := uint64(len(h))
+	for _, component := range h {
+		hash = HashCombine(hash, component.Hash(key))
+	}
+	return hash
+}
+
+// Equal reports whether every component of both keys is equal
+func (h tupleHasher[K]) Equal(a, b K) bool {
+	for _, component := range h {
+		if !component.Equal(a, b) {
+			return false
+		}
+	}
+	return true
+}
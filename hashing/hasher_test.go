@@ -0,0 +1,66 @@
+package hashing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// caseInsensitive compares strings regardless of their case
+var caseInsensitive = NewFuncHasher(
+	func(key string) uint64 { return NewFNVHash[string]().Hash(strings.ToLower(key)) },
+	strings.EqualFold,
+)
+
+func TestComparableHasher(t *testing.T) {
+	hasher := NewComparableHasher[int](NewFNVHash[int]())
+	assert.Equal(t, NewFNVHash[int]().Hash(7), hasher.Hash(7), "The hash function should be used as is")
+	assert.True(t, hasher.Equal(7, 7), "Equal keys should be equal")
+	assert.False(t, hasher.Equal(7, 8), "Different keys should not be equal")
+}
+
+func TestFuncHasher(t *testing.T) {
+	assert.True(t, caseInsensitive.Equal("Key", "kEY"), "The equality function should be used")
+	assert.Equal(t, caseInsensitive.Hash("Key"), caseInsensitive.Hash("kEY"), "The hash function should be used")
+	assert.False(t, caseInsensitive.Equal("key", "keys"), "Different keys should not be equal")
+}
+
+func TestHashCombine(t *testing.T) {
+	a, b := uint64(1), uint64(2)
+	assert.NotEqual(t, HashCombine(HashCombine(0, a), b), HashCombine(HashCombine(0, b), a), "The order of the hashes should matter")
+	assert.NotEqual(t, HashCombine(0, a), HashCombine(1, a), "The seed should matter")
+}
+
+func TestHashSlice(t *testing.T) {
+	hasher := HashSlice(NewComparableHasher[int](NewFNVHash[int]()))
+	assert.True(t, hasher.Equal([]int{1, 2, 3}, []int{1, 2, 3}), "Slices with equal elements should be equal")
+	assert.Equal(t, hasher.Hash([]int{1, 2, 3}), hasher.Hash([]int{1, 2, 3}), "Equal slices should hash the same")
+	assert.False(t, hasher.Equal([]int{1, 2, 3}, []int{3, 2, 1}), "The order of the elements should matter")
+	assert.NotEqual(t, hasher.Hash([]int{1, 2, 3}), hasher.Hash([]int{3, 2, 1}), "The order of the elements should change the hash")
+	assert.False(t, hasher.Equal([]int{1, 2}, []int{1, 2, 3}), "Slices of different lengths should not be equal")
+	assert.True(t, hasher.Equal(nil, []int{}), "Nil and empty slices should be equal")
+	assert.Equal(t, hasher.Hash(nil), hasher.Hash([]int{}), "Nil and empty slices should hash the same")
+
+	words := HashSlice(caseInsensitive)
+	assert.True(t, words.Equal([]string{"A", "b"}, []string{"a", "B"}), "The element hasher should decide equality")
+	assert.Equal(t, words.Hash([]string{"A", "b"}), words.Hash([]string{"a", "B"}), "The element hasher should decide the hash")
+}
+
+func TestHashTuple(t *testing.T) {
+	type user struct {
+		name string
+		tags []int
+	}
+	hasher := HashTuple(
+		HashField(func(u user) string { return u.name }, caseInsensitive),
+		HashField(func(u user) []int { return u.tags }, HashSlice(NewComparableHasher[int](NewFNVHash[int]()))),
+	)
+
+	alice := user{name: "Alice", tags: []int{1, 2}}
+	assert.True(t, hasher.Equal(alice, user{name: "ALICE", tags: []int{1, 2}}), "Keys with equal components should be equal")
+	assert.Equal(t, hasher.Hash(alice), hasher.Hash(user{name: "ALICE", tags: []int{1, 2}}), "Equal keys should hash the same")
+	assert.False(t, hasher.Equal(alice, user{name: "Alice", tags: []int{2, 1}}), "Every component should be compared")
+	assert.False(t, hasher.Equal(alice, user{name: "Bob", tags: []int{1, 2}}), "Every component should be compared")
+	assert.NotEqual(t, hasher.Hash(alice), hasher.Hash(user{name: "Bob", tags: []int{1, 2}}), "Different keys should hash differently")
+}
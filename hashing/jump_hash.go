@@ -0,0 +1,106 @@
+package hashing
+
+import (
+	"maps"
+	"slices"
+)
+
+// JumpHash implements Jump Consistent Hash, which maps keys to a range of buckets with no memory
+// and near perfect balance. Each member owns as many consecutive buckets as its weight.
+// Keys only move to the new member when one is added, but removing any member other than the
+// last one added also moves the keys of the member whose buckets take the place of its own.
+type JumpHash[K comparable] struct {
+	hashFunc HashFunction[K]
+	buckets  []string // Member owning each bucket
+}
+
+// Ensure JumpHash implements ConsistentHash
+var _ ConsistentHash[string] = (*JumpHash[string])(nil)
+
+// NewJumpHash creates an empty set of members hashing keys with hashFunc
+func NewJumpHash[K comparable](hashFunc HashFunction[K]) *JumpHash[K] {
+	return &JumpHash[K]{hashFunc: hashFunc}
+}
+
+// Add appends weight buckets owned by the member, replacing its current ones if it was already added
+func (j *JumpHash[K]) Add(member string, weight int) error {
+	if weight < 1 {
+		return errInvalidWeight
+	}
+	j.removeBuckets(member)
+	for i := 0; i < weight; i++ {
+		j.buckets = append(j.buckets, member)
+	}
+	return nil
+}
+
+// Remove removes the buckets of a member, filling the gaps with the last buckets
+func (j *JumpHash[K]) Remove(member string) error {
+	if !j.removeBuckets(member) {
+		return errUnknownMember
+	}
+	return nil
+}
+
+// Members returns the members in ascending order
+func (j *JumpHash[K]) Members() []string {
+	members := make(map[string]struct{})
+	for _, member := range j.buckets {
+		members[member] = struct{}{}
+	}
+	return slices.Sorted(maps.Keys(members))
+}
+
+// Locate returns the member owning the bucket the key jumps to
+func (j *JumpHash[K]) Locate(key K) (string, bool) {
+	if len(j.buckets) == 0 {
+		return "", false
+	}
+	return j.buckets[jumpBucket(j.hashFunc.Hash(key), len(j.buckets))], true
+}
+
+// LocateN returns the member owning the bucket the key jumps to, followed by the owners of the
+// next buckets in order until n distinct members have been found
+func (j *JumpHash[K]) LocateN(key K, n int) []string {
+	if n <= 0 || len(j.buckets) == 0 {
+		return nil
+	}
+
+	members := make([]string, 0, n)
+	start := jumpBucket(j.hashFunc.Hash(key), len(j.buckets))
+	for i := 0; i < len(j.buckets) && len(members) < n; i++ {
+		member := j.buckets[(start+i)%len(j.buckets)]
+		if !slices.Contains(members, member) {
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+// removeBuckets removes every bucket of a member, moving the last buckets into the gaps,
+// and reports whether the member owned any
+func (j *JumpHash[K]) removeBuckets(member string) bool {
+	removed := false
+	for i := 0; i < len(j.buckets); {
+		if j.buckets[i] != member {
+			i++
+			continue
+		}
+		last := len(j.buckets) - 1
+		j.buckets[i] = j.buckets[last]
+		j.buckets = j.buckets[:last]
+		removed = true
+	}
+	return removed
+}
+
+// jumpBucket returns the bucket in [0, buckets) a key hash maps to, as published by Lamping and Veach
+func jumpBucket(key uint64, buckets int) int {
+	var b, j int64 = -1, 0
+	for j < int64(buckets) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+	return int(b)
+}
@@ -0,0 +1,37 @@
+package hashing
+
+import "hash/maphash"
+
+// MapHash hashes keys with the runtime's own seeded hash, as used by Go maps.
+// Its results are only stable for a given seed within a single process.
+type MapHash[K comparable] struct {
+	seed maphash.Seed
+}
+
+// Ensure MapHash implements SeededHashFunction
+var _ SeededHashFunction[int] = (*MapHash[int])(nil)
+
+// NewMapHash creates a new maphash based hash function with a random seed
+func NewMapHash[K comparable]() *MapHash[K] {
+	return NewMapHashWithSeed[K](maphash.MakeSeed())
+}
+
+// NewMapHashWithSeed creates a new maphash based hash function with the given seed
+func NewMapHashWithSeed[K comparable](seed maphash.Seed) *MapHash[K] {
+	return &MapHash[K]{seed: seed}
+}
+
+// Hash computes the hash of the key under the seed of the hash function
+func (m *MapHash[K]) Hash(key K) uint64 {
+	return maphash.Comparable(m.seed, key)
+}
+
+// Seed returns the seed of the hash function, so that another one can reproduce its results
+func (m *MapHash[K]) Seed() maphash.Seed {
+	return m.seed
+}
+
+// Reseed returns a MapHash with a fresh random seed
+func (m *MapHash[K]) Reseed() SeededHashFunction[K] {
+	return NewMapHash[K]()
+}
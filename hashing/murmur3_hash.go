@@ -0,0 +1,377 @@
+package hashing
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+const (
+	murmur32C1 uint32 = 0xcc9e2d51
+	murmur32C2 uint32 = 0x1b873593
+
+	murmur128C1 uint64 = 0x87c37b91114253d5
+	murmur128C2 uint64 = 0x4cf5ad432745937f
+)
+
+// Murmur3Hash32 implements the 32-bit x86 variant of MurmurHash3, its hashes only use the low 32 bits
+type Murmur3Hash32[K comparable] struct {
+	seed uint32
+}
+
+// Ensure Murmur3Hash32 implements HashFunction
+var _ HashFunction[int] = (*Murmur3Hash32[int])(nil)
+
+// NewMurmur3Hash32 creates a new 32-bit MurmurHash3 hash function with the given seed
+func NewMurmur3Hash32[K comparable](seed uint32) *Murmur3Hash32[K] {
+	return &Murmur3Hash32[K]{seed: seed}
+}
+
+// Hash computes the 32-bit MurmurHash3 of the key without allocating.
+// Strings, booleans, integers and floats are hashed along with a tag for their type,
+// any other key is first hashed by maphash.Comparable, whose results are only stable within a process,
+// and that hash is then hashed under the seed.
+func (m *Murmur3Hash32[K]) Hash(key K) uint64 {
+	tag, bits, text := seedableKey(key)
+
+	digest := Murmur3Digest32{seed: m.seed, hash: m.seed}
+	digest.writeKey(tag, bits, text)
+	return uint64(digest.Sum32())
+}
+
+// Murmur3Hash128 implements the 128-bit x64 variant of MurmurHash3, its hashes are the first 64 bits
+type Murmur3Hash128[K comparable] struct {
+	seed uint64
+}
+
+// Ensure Murmur3Hash128 implements HashFunction
+var _ HashFunction[int] = (*Murmur3Hash128[int])(nil)
+
+// NewMurmur3Hash128 creates a new 128-bit MurmurHash3 hash function with the given seed
+func NewMurmur3Hash128[K comparable](seed uint64) *Murmur3Hash128[K] {
+	return &Murmur3Hash128[K]{seed: seed}
+}
+
+// Hash computes the 128-bit MurmurHash3 of the key without allocating and returns its first half.
+// Strings, booleans, integers and floats are hashed along with a tag for their type,
+// any other key is first hashed by maphash.Comparable, whose results are only stable within a process,
+// and that hash is then hashed under the seed.
+func (m *Murmur3Hash128[K]) Hash(key K) uint64 {
+	tag, bits, text := seedableKey(key)
+
+	digest := Murmur3Digest128{seed: m.seed, h1: m.seed, h2: m.seed}
+	digest.writeKey(tag, bits, text)
+	return digest.Sum64()
+}
+
+// Murmur3Digest32 is a streaming 32-bit MurmurHash3 computation
+type Murmur3Digest32 struct {
+	seed     uint32
+	hash     uint32
+	total    uint64  // Number of bytes written
+	pending  [4]byte // Bytes written since the last complete block
+	buffered int     // Number of bytes in pending
+}
+
+// Ensure Murmur3Digest32 implements Digest
+var _ Digest = (*Murmur3Digest32)(nil)
+
+// NewMurmur3Digest32 creates a new streaming 32-bit MurmurHash3 computation with the given seed
+func NewMurmur3Digest32(seed uint32) *Murmur3Digest32 {
+	return &Murmur3Digest32{seed: seed, hash: seed}
+}
+
+// Reset discards the input written so far, keeping the seed
+func (d *Murmur3Digest32) Reset() {
+	*d = Murmur3Digest32{seed: d.seed, hash: d.seed}
+}
+
+// Size returns the number of bytes Sum appends
+func (d *Murmur3Digest32) Size() int {
+	return 4
+}
+
+// BlockSize returns the number of bytes the algorithm consumes at a time
+func (d *Murmur3Digest32) BlockSize() int {
+	return len(d.pending)
+}
+
+// Write appends p to the input, it never fails
+func (d *Murmur3Digest32) Write(p []byte) (int, error) {
+	murmur32Write(d, p)
+	return len(p), nil
+}
+
+// WriteString appends the bytes of s to the input, it never fails
+func (d *Murmur3Digest32) WriteString(s string) (int, error) {
+	murmur32Write(d, s)
+	return len(s), nil
+}
+
+// WriteByte appends a single byte to the input, it never fails
+func (d *Murmur3Digest32) WriteByte(b byte) error {
+	d.total++
+	d.pending[d.buffered] = b
+	d.buffered++
+	if d.buffered == len(d.pending) {
+		d.hash = murmur32Block(d.hash, readUint32(d.pending[:]))
+		d.buffered = 0
+	}
+	return nil
+}
+
+// WriteUint64 appends the little endian bytes of value to the input
+func (d *Murmur3Digest32) WriteUint64(value uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], value)
+	murmur32Write(d, buf[:])
+}
+
+// Sum appends the big endian hash of the input to b
+func (d *Murmur3Digest32) Sum(b []byte) []byte {
+	return binary.BigEndian.AppendUint32(b, d.Sum32())
+}
+
+// Sum32 returns the hash of the input written so far, more input can still be written afterwards
+func (d *Murmur3Digest32) Sum32() uint32 {
+	hash := d.hash
+	if d.buffered > 0 {
+		var k uint32
+		for i := d.buffered - 1; i >= 0; i-- {
+			k = k<<8 | uint32(d.pending[i])
+		}
+		hash ^= murmur32Scramble(k)
+	}
+
+	hash ^= uint32(d.total)
+	hash ^= hash >> 16
+	hash *= 0x85ebca6b
+	hash ^= hash >> 13
+	hash *= 0xc2b2ae35
+	hash ^= hash >> 16
+	return hash
+}
+
+// Sum64 returns the hash of the input written so far widened to 64 bits
+func (d *Murmur3Digest32) Sum64() uint64 {
+	return uint64(d.Sum32())
+}
+
+// writeKey feeds a key decomposed by basicKey into the digest
+func (d *Murmur3Digest32) writeKey(tag byte, bits uint64, text string) {
+	_ = d.WriteByte(tag)
+	if tag == tagString {
+		_, _ = d.WriteString(text)
+	} else {
+		d.WriteUint64(bits)
+	}
+}
+
+// murmur32Write appends p to the input of the digest, consuming whole blocks straight from p
+func murmur32Write[S byteSeq](d *Murmur3Digest32, p S) {
+	d.total += uint64(len(p))
+	if d.buffered > 0 {
+		n := copy(d.pending[d.buffered:], p)
+		d.buffered += n
+		p = p[n:]
+		if d.buffered < len(d.pending) {
+			return
+		}
+		d.hash = murmur32Block(d.hash, readUint32(d.pending[:]))
+		d.buffered = 0
+	}
+
+	hash := d.hash
+	for ; len(p) >= 4; p = p[4:] {
+		hash = murmur32Block(hash, readUint32(p))
+	}
+	d.hash = hash
+	d.buffered = copy(d.pending[:], p)
+}
+
+// murmur32Scramble mixes a block of input before it is folded into the hash
+func murmur32Scramble(k uint32) uint32 {
+	k *= murmur32C1
+	k = bits.RotateLeft32(k, 15)
+	return k * murmur32C2
+}
+
+// murmur32Block folds a block of input into the hash
+func murmur32Block(hash, k uint32) uint32 {
+	hash ^= murmur32Scramble(k)
+	hash = bits.RotateLeft32(hash, 13)
+	return hash*5 + 0xe6546b64
+}
+
+// Murmur3Digest128 is a streaming 128-bit MurmurHash3 computation
+type Murmur3Digest128 struct {
+	seed     uint64
+	h1, h2   uint64
+	total    uint64   // Number of bytes written
+	pending  [16]byte // Bytes written since the last complete block
+	buffered int      // Number of bytes in pending
+}
+
+// Ensure Murmur3Digest128 implements Digest
+var _ Digest = (*Murmur3Digest128)(nil)
+
+// NewMurmur3Digest128 creates a new streaming 128-bit MurmurHash3 computation with the given seed
+func NewMurmur3Digest128(seed uint64) *Murmur3Digest128 {
+	return &Murmur3Digest128{seed: seed, h1: seed, h2: seed}
+}
+
+// Reset discards the input written so far, keeping the seed
+func (d *Murmur3Digest128) Reset() {
+	*d = Murmur3Digest128{seed: d.seed, h1: d.seed, h2: d.seed}
+}
+
+// Size returns the number of bytes Sum appends
+func (d *Murmur3Digest128) Size() int {
+	return 16
+}
+
+// BlockSize returns the number of bytes the algorithm consumes at a time
+func (d *Murmur3Digest128) BlockSize() int {
+	return len(d.pending)
+}
+
+// Write appends p to the input, it never fails
+func (d *Murmur3Digest128) Write(p []byte) (int, error) {
+	murmur128Write(d, p)
+	return len(p), nil
+}
+
+// WriteString appends the bytes of s to the input, it never fails
+func (d *Murmur3Digest128) WriteString(s string) (int, error) {
+	murmur128Write(d, s)
+	return len(s), nil
+}
+
+// WriteByte appends a single byte to the input, it never fails
+func (d *Murmur3Digest128) WriteByte(b byte) error {
+	d.total++
+	d.pending[d.buffered] = b
+	d.buffered++
+	if d.buffered == len(d.pending) {
+		d.h1, d.h2 = murmur128Block(d.h1, d.h2, readUint64(d.pending[:]), readUint64(d.pending[8:]))
+		d.buffered = 0
+	}
+	return nil
+}
+
+// WriteUint64 appends the little endian bytes of value to the input
+func (d *Murmur3Digest128) WriteUint64(value uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], value)
+	murmur128Write(d, buf[:])
+}
+
+// Sum appends the 16 bytes of the hash of the input to b, in the order of the reference implementation
+func (d *Murmur3Digest128) Sum(b []byte) []byte {
+	h1, h2 := d.Sum128()
+	b = binary.LittleEndian.AppendUint64(b, h1)
+	return binary.LittleEndian.AppendUint64(b, h2)
+}
+
+// Sum64 returns the first half of the hash of the input written so far
+func (d *Murmur3Digest128) Sum64() uint64 {
+	h1, _ := d.Sum128()
+	return h1
+}
+
+// Sum128 returns both halves of the hash of the input written so far, more input can still be written afterwards
+func (d *Murmur3Digest128) Sum128() (uint64, uint64) {
+	h1, h2 := d.h1, d.h2
+	if d.buffered > 0 {
+		var k1, k2 uint64
+		for i := d.buffered - 1; i >= 8; i-- {
+			k2 = k2<<8 | uint64(d.pending[i])
+		}
+		for i := min(d.buffered, 8) - 1; i >= 0; i-- {
+			k1 = k1<<8 | uint64(d.pending[i])
+		}
+		if d.buffered > 8 {
+			h2 ^= murmur128ScrambleK2(k2)
+		}
+		h1 ^= murmur128ScrambleK1(k1)
+	}
+
+	h1 ^= d.total
+	h2 ^= d.total
+	h1 += h2
+	h2 += h1
+	h1 = murmur128Finalize(h1)
+	h2 = murmur128Finalize(h2)
+	h1 += h2
+	h2 += h1
+	return h1, h2
+}
+
+// writeKey feeds a key decomposed by basicKey into the digest
+func (d *Murmur3Digest128) writeKey(tag byte, bits uint64, text string) {
+	_ = d.WriteByte(tag)
+	if tag == tagString {
+		_, _ = d.WriteString(text)
+	} else {
+		d.WriteUint64(bits)
+	}
+}
+
+// murmur128Write appends p to the input of the digest, consuming whole blocks straight from p
+func murmur128Write[S byteSeq](d *Murmur3Digest128, p S) {
+	d.total += uint64(len(p))
+	if d.buffered > 0 {
+		n := copy(d.pending[d.buffered:], p)
+		d.buffered += n
+		p = p[n:]
+		if d.buffered < len(d.pending) {
+			return
+		}
+		d.h1, d.h2 = murmur128Block(d.h1, d.h2, readUint64(d.pending[:]), readUint64(d.pending[8:]))
+		d.buffered = 0
+	}
+
+	h1, h2 := d.h1, d.h2
+	for ; len(p) >= 16; p = p[16:] {
+		h1, h2 = murmur128Block(h1, h2, readUint64(p), readUint64(p[8:]))
+	}
+	d.h1, d.h2 = h1, h2
+	d.buffered = copy(d.pending[:], p)
+}
+
+// murmur128ScrambleK1 mixes the first half of a block of input before it is folded into the hash
+func murmur128ScrambleK1(k1 uint64) uint64 {
+	k1 *= murmur128C1
+	k1 = bits.RotateLeft64(k1, 31)
+	return k1 * murmur128C2
+}
+
+// murmur128ScrambleK2 mixes the second half of a block of input before it is folded into the hash
+func murmur128ScrambleK2(k2 uint64) uint64 {
+	k2 *= murmur128C2
+	k2 = bits.RotateLeft64(k2, 33)
+	return k2 * murmur128C1
+}
+
+// murmur128Block folds a block of input into both halves of the hash
+func murmur128Block(h1, h2, k1, k2 uint64) (uint64, uint64) {
+	h1 ^= murmur128ScrambleK1(k1)
+	h1 = bits.RotateLeft64(h1, 27)
+	h1 += h2
+	h1 = h1*5 + 0x52dce729
+
+	h2 ^= murmur128ScrambleK2(k2)
+	h2 = bits.RotateLeft64(h2, 31)
+	h2 += h1
+	h2 = h2*5 + 0x38495ab5
+	return h1, h2
+}
+
+// murmur128Finalize forces all bits of a half of the hash to avalanche
+func murmur128Finalize(k uint64) uint64 {
+	k ^= k >> 33
+	k *= 0xff51afd7ed558ccd
+	k ^= k >> 33
+	k *= 0xc4ceb9fe1a85ec53
+	k ^= k >> 33
+	return k
+}
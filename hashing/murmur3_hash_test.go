@@ -0,0 +1,64 @@
+package hashing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMurmur3Hash32_ReferenceVectors(t *testing.T) {
+	vectors := []struct {
+		input    string
+		seed     uint32
+		expected uint32
+	}{
+		{"", 0, 0},
+		{"", 1, 0x514e28b7},
+		{"", 0xffffffff, 0x81f16f39},
+		{"hello", 0, 0x248bfa47},
+		{"Hello, world!", 1234, 0xfaf6cdb3},
+		{"The quick brown fox jumps over the lazy dog", 0, 0x2e4ff723},
+	}
+
+	for _, vector := range vectors {
+		digest := NewMurmur3Digest32(vector.seed)
+		_, _ = digest.WriteString(vector.input)
+		assert.Equal(t, vector.expected, digest.Sum32(), "Unexpected MurmurHash3 of %q with seed %d", vector.input, vector.seed)
+	}
+}
+
+func TestMurmur3Hash128_ReferenceVectors(t *testing.T) {
+	vectors := []struct {
+		input  string
+		h1, h2 uint64
+	}{
+		{"", 0, 0},
+		{"hello", 0xcbd8a7b341bd9b02, 0x5b1e906a48ae1d19},
+		{"The quick brown fox jumps over the lazy dog", 0xe34bbc7bbc071b6c, 0x7a433ca9c49a9347},
+	}
+
+	for _, vector := range vectors {
+		digest := NewMurmur3Digest128(0)
+		_, _ = digest.WriteString(vector.input)
+		h1, h2 := digest.Sum128()
+		assert.Equal(t, vector.h1, h1, "Unexpected first half of the MurmurHash3 of %q", vector.input)
+		assert.Equal(t, vector.h2, h2, "Unexpected second half of the MurmurHash3 of %q", vector.input)
+	}
+}
+
+func TestMurmur3Hash_Keys(t *testing.T) {
+	narrow := NewMurmur3Hash32[any](0)
+	assert.Equal(t, narrow.Hash("key"), narrow.Hash("key"), "Equal keys should hash the same")
+	assert.NotEqual(t, narrow.Hash(int64(1)), narrow.Hash(uint64(1)), "Equal bits of different types should not collide")
+	assert.Zero(t, narrow.Hash("key")>>32, "The 32-bit variant should only use the low 32 bits")
+
+	wide := NewMurmur3Hash128[any](0)
+	assert.Equal(t, wide.Hash("key"), wide.Hash("key"), "Equal keys should hash the same")
+	assert.NotEqual(t, wide.Hash("key"), NewMurmur3Hash128[any](1).Hash("key"), "The seed should change the hashes")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		narrow.Hash(42)
+		wide.Hash("a key long enough to span a block")
+	})
+	assert.Zero(t, allocs, "Hashing should not allocate")
+}
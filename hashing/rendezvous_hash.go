@@ -0,0 +1,102 @@
+package hashing
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+)
+
+// RendezvousHash implements highest random weight hashing: every member scores every key,
+// and a key belongs to the member with the highest score. Lookups take time linear in the number
+// of members but need no memory beyond the members themselves.
+type RendezvousHash[K comparable] struct {
+	hashFunc HashFunction[K]
+	members  map[string]rendezvousMember
+}
+
+// rendezvousMember holds the weight of a member along with the hash of its name
+type rendezvousMember struct {
+	hash   uint64
+	weight int
+}
+
+// rankedMember is a member along with its score for a key
+type rankedMember struct {
+	name  string
+	score float64
+}
+
+// Ensure RendezvousHash implements ConsistentHash
+var _ ConsistentHash[string] = (*RendezvousHash[string])(nil)
+
+// NewRendezvousHash creates an empty set of members hashing keys with hashFunc
+func NewRendezvousHash[K comparable](hashFunc HashFunction[K]) *RendezvousHash[K] {
+	return &RendezvousHash[K]{
+		hashFunc: hashFunc,
+		members:  make(map[string]rendezvousMember),
+	}
+}
+
+// Add adds a member winning a share of the keys proportional to its weight, or updates its weight
+func (r *RendezvousHash[K]) Add(member string, weight int) error {
+	if weight < 1 {
+		return errInvalidWeight
+	}
+	r.members[member] = rendezvousMember{hash: memberHash(member, 0), weight: weight}
+	return nil
+}
+
+// Remove removes a member, each of its keys moves to the member that scored second for it
+func (r *RendezvousHash[K]) Remove(member string) error {
+	if _, ok := r.members[member]; !ok {
+		return errUnknownMember
+	}
+	delete(r.members, member)
+	return nil
+}
+
+// Members returns the members in ascending order
+func (r *RendezvousHash[K]) Members() []string {
+	return slices.Sorted(maps.Keys(r.members))
+}
+
+// Locate returns the member with the highest score for the key
+func (r *RendezvousHash[K]) Locate(key K) (string, bool) {
+	keyHash := r.hashFunc.Hash(key)
+
+	var best rankedMember
+	found := false
+	for name, member := range r.members {
+		candidate := rankedMember{name: name, score: rendezvousScore(keyHash, member.hash, member.weight)}
+		if !found || compareRanks(candidate, best) < 0 {
+			best, found = candidate, true
+		}
+	}
+	return best.name, found
+}
+
+// LocateN returns the n members with the highest scores for the key, best first
+func (r *RendezvousHash[K]) LocateN(key K, n int) []string {
+	n = min(n, len(r.members))
+	if n <= 0 {
+		return nil
+	}
+
+	keyHash := r.hashFunc.Hash(key)
+	ranked := make([]rankedMember, 0, len(r.members))
+	for name, member := range r.members {
+		ranked = append(ranked, rankedMember{name: name, score: rendezvousScore(keyHash, member.hash, member.weight)})
+	}
+	slices.SortFunc(ranked, compareRanks)
+
+	members := make([]string, n)
+	for i := range members {
+		members[i] = ranked[i].name
+	}
+	return members
+}
+
+// compareRanks orders members by decreasing score, breaking ties by name so that every process agrees
+func compareRanks(a, b rankedMember) int {
+	return cmp.Or(cmp.Compare(b.score, a.score), cmp.Compare(a.name, b.name))
+}
@@ -0,0 +1,136 @@
+package hashing
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"math/bits"
+)
+
+// SipHash implements SipHash-2-4, a keyed hash function that resists hash flooding as long as its key stays secret
+type SipHash[K comparable] struct {
+	k0, k1 uint64
+}
+
+// Ensure SipHash implements SeededHashFunction
+var _ SeededHashFunction[int] = (*SipHash[int])(nil)
+
+// NewSipHash creates a new SipHash-2-4 hash function keyed with a random 128-bit key
+func NewSipHash[K comparable]() *SipHash[K] {
+	var key [16]byte
+	_, _ = rand.Read(key[:]) // crypto/rand.Read never returns an error
+	return NewSipHashWithKey[K](binary.LittleEndian.Uint64(key[:8]), binary.LittleEndian.Uint64(key[8:]))
+}
+
+// NewSipHashWithKey creates a new SipHash-2-4 hash function keyed with k0 and k1, the little endian halves of the key
+func NewSipHashWithKey[K comparable](k0, k1 uint64) *SipHash[K] {
+	return &SipHash[K]{k0: k0, k1: k1}
+}
+
+// Hash computes the SipHash-2-4 of the key without allocating.
+// Strings, booleans, integers and floats are hashed along with a tag for their type. Any other key is
+// first hashed by maphash.Comparable, whose results are only stable within a process, and that hash is
+// then keyed like the others, so instances with the same key agree on every key within a process.
+func (s *SipHash[K]) Hash(key K) uint64 {
+	tag, bits, text := seedableKey(key)
+
+	state := newSipState(s.k0, s.k1)
+	state.writeByte(tag)
+	if tag == tagString {
+		state.writeString(text)
+	} else {
+		state.writeUint64(bits)
+	}
+	return state.sum()
+}
+
+// Reseed returns a SipHash keyed with a fresh random key
+func (s *SipHash[K]) Reseed() SeededHashFunction[K] {
+	return NewSipHash[K]()
+}
+
+// sipState is the running state of a SipHash-2-4 computation
+type sipState struct {
+	v0, v1, v2, v3 uint64
+	tail           uint64 // Bytes written since the last complete block, little endian
+	length         int    // Total number of bytes written
+}
+
+// newSipState initialises the state for the given key
+func newSipState(k0, k1 uint64) sipState {
+	return sipState{
+		v0: k0 ^ 0x736f6d6570736575,
+		v1: k1 ^ 0x646f72616e646f6d,
+		v2: k0 ^ 0x6c7967656e657261,
+		v3: k1 ^ 0x7465646279746573,
+	}
+}
+
+// writeByte appends a single byte to the message
+func (s *sipState) writeByte(b byte) {
+	s.tail |= uint64(b) << (8 * (s.length & 7))
+	s.length++
+	if s.length&7 == 0 {
+		s.compress(s.tail)
+		s.tail = 0
+	}
+}
+
+// writeString appends the bytes of text to the message, a whole block at a time where possible
+func (s *sipState) writeString(text string) {
+	for len(text) > 0 && s.length&7 != 0 {
+		s.writeByte(text[0])
+		text = text[1:]
+	}
+	for ; len(text) >= 8; text = text[8:] {
+		s.compress(uint64(text[0]) | uint64(text[1])<<8 | uint64(text[2])<<16 | uint64(text[3])<<24 |
+			uint64(text[4])<<32 | uint64(text[5])<<40 | uint64(text[6])<<48 | uint64(text[7])<<56)
+		s.length += 8
+	}
+	for i := 0; i < len(text); i++ {
+		s.writeByte(text[i])
+	}
+}
+
+// writeUint64 appends the little endian bytes of value to the message
+func (s *sipState) writeUint64(value uint64) {
+	for i := 0; i < 8; i++ {
+		s.writeByte(byte(value))
+		value >>= 8
+	}
+}
+
+// compress mixes a block of the message into the state with two SipRounds
+func (s *sipState) compress(block uint64) {
+	s.v3 ^= block
+	s.round()
+	s.round()
+	s.v0 ^= block
+}
+
+// sum pads the message with its length and returns the hash after four finalisation rounds
+func (s *sipState) sum() uint64 {
+	s.compress(s.tail | uint64(s.length)<<56)
+	s.v2 ^= 0xff
+	for i := 0; i < 4; i++ {
+		s.round()
+	}
+	return s.v0 ^ s.v1 ^ s.v2 ^ s.v3
+}
+
+// round is a single SipRound
+func (s *sipState) round() {
+	s.v0 += s.v1
+	s.v1 = bits.RotateLeft64(s.v1, 13)
+	s.v1 ^= s.v0
+	s.v0 = bits.RotateLeft64(s.v0, 32)
+	s.v2 += s.v3
+	s.v3 = bits.RotateLeft64(s.v3, 16)
+	s.v3 ^= s.v2
+	s.v0 += s.v3
+	s.v3 = bits.RotateLeft64(s.v3, 21)
+	s.v3 ^= s.v0
+	s.v2 += s.v1
+	s.v1 = bits.RotateLeft64(s.v1, 17)
+	s.v1 ^= s.v2
+	s.v2 = bits.RotateLeft64(s.v2, 32)
+}
@@ -0,0 +1,83 @@
+package hashing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSipHash_ReferenceVectors(t *testing.T) {
+	// Vectors from the SipHash reference implementation: key 00 01 .. 0f, message 00 01 .. (n-1)
+	expected := map[int]uint64{
+		0:  0x726fdb47dd0e0e31,
+		1:  0x74f839c593dc67fd,
+		2:  0x0d6c8009d9a94f5a,
+		3:  0x85676696d7fb7e2d,
+		15: 0xa129ca6149be45e5,
+	}
+
+	for n, want := range expected {
+		message := make([]byte, n)
+		for i := range message {
+			message[i] = byte(i)
+		}
+
+		bytewise := newSipState(0x0706050403020100, 0x0f0e0d0c0b0a0908)
+		for _, b := range message {
+			bytewise.writeByte(b)
+		}
+		assert.Equal(t, want, bytewise.sum(), "Unexpected SipHash-2-4 of %d bytes", n)
+
+		blockwise := newSipState(0x0706050403020100, 0x0f0e0d0c0b0a0908)
+		blockwise.writeString(string(message))
+		assert.Equal(t, want, blockwise.sum(), "Writing %d bytes as a string should give the same hash", n)
+	}
+}
+
+func TestSipHash_KeyedResults(t *testing.T) {
+	a := NewSipHashWithKey[string](1, 2)
+	b := NewSipHashWithKey[string](1, 2)
+	c := NewSipHashWithKey[string](2, 1)
+
+	assert.Equal(t, a.Hash("hello"), b.Hash("hello"), "Equal keys should give equal hashes")
+	assert.NotEqual(t, a.Hash("hello"), c.Hash("hello"), "Different keys should give different hashes")
+	assert.NotEqual(t, a.Hash("hello"), a.Hash("world"), "Different strings should hash differently")
+
+	random := NewSipHash[string]()
+	reseeded := random.Reseed()
+	assert.NotEqual(t, random.Hash("hello"), reseeded.Hash("hello"), "Reseeding should change the hashes")
+}
+
+func TestSipHash_TypesAndFallback(t *testing.T) {
+	keys := NewSipHashWithKey[any](1, 2)
+	assert.NotEqual(t, keys.Hash(int32(1)), keys.Hash(uint32(1)), "Equal bits of different types should not collide")
+	assert.Equal(t, keys.Hash(0.0), keys.Hash(-1*0.0), "Signed zeros should hash the same")
+
+	type point struct{ x, y int }
+	points := NewSipHash[point]()
+	assert.Equal(t, points.Hash(point{1, 2}), points.Hash(point{1, 2}), "Equal keys should hash the same")
+	assert.NotEqual(t, points.Hash(point{1, 2}), points.Hash(point{2, 1}), "Different keys should hash differently")
+
+	samePoints := NewSipHashWithKey[point](1, 2)
+	assert.Equal(t, samePoints.Hash(point{1, 2}), NewSipHashWithKey[point](1, 2).Hash(point{1, 2}), "Instances with the same key should agree on struct keys")
+	assert.NotEqual(t, samePoints.Hash(point{1, 2}), NewSipHashWithKey[point](2, 1).Hash(point{1, 2}), "The key should change the hashes of struct keys")
+}
+
+func TestSipHash_DoesNotAllocate(t *testing.T) {
+	strings := NewSipHash[string]()
+	ints := NewSipHash[int]()
+	allocs := testing.AllocsPerRun(100, func() {
+		strings.Hash("a key long enough to span several blocks")
+		ints.Hash(42)
+	})
+	assert.Zero(t, allocs, "Hashing should not allocate")
+}
+
+func TestMapHash_Seeds(t *testing.T) {
+	a := NewMapHash[string]()
+	b := NewMapHashWithSeed[string](a.Seed())
+
+	assert.Equal(t, a.Hash("hello"), b.Hash("hello"), "Hash functions sharing a seed should agree")
+	assert.NotEqual(t, a.Hash("hello"), a.Reseed().Hash("hello"), "Reseeding should change the hashes")
+	assert.NotEqual(t, a.Hash("hello"), a.Hash("world"), "Different strings should hash differently")
+}
@@ -0,0 +1,215 @@
+package hashing
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// wySecret is the default secret of the final version 4 of wyhash
+var wySecret = [4]uint64{0x2d358dccaa6c78a5, 0x8bb84b93962eacc9, 0x4b33a62ed433d4a3, 0x4d5a2da51de1aa47}
+
+// wyBlock is the number of bytes wyhash consumes at a time on long inputs
+const wyBlock = 48
+
+// WyHash implements wyhash (final version 4), one of the fastest hash functions passing SMHasher
+type WyHash[K comparable] struct {
+	seed uint64
+}
+
+// Ensure WyHash implements HashFunction
+var _ HashFunction[int] = (*WyHash[int])(nil)
+
+// NewWyHash creates a new wyhash hash function with the given seed
+func NewWyHash[K comparable](seed uint64) *WyHash[K] {
+	return &WyHash[K]{seed: seed}
+}
+
+// Hash computes the wyhash of the key without allocating.
+// Strings, booleans, integers and floats are hashed along with a tag for their type,
+// any other key is first hashed by maphash.Comparable, whose results are only stable within a process,
+// and that hash is then hashed under the seed.
+func (w *WyHash[K]) Hash(key K) uint64 {
+	tag, bits, text := seedableKey(key)
+
+	var digest WyHashDigest
+	digest.reset(w.seed)
+	digest.writeKey(tag, bits, text)
+	return digest.Sum64()
+}
+
+// WyHashDigest is a streaming wyhash computation.
+// wyhash only consumes a block while more input follows it, finishing the last 1 to 48 bytes differently,
+// so a full block stays pending until the next byte arrives. Those final steps also read the last 16 bytes
+// of the input even when they belong to a block that was already consumed, so the digest keeps those of
+// the last block right before the pending bytes.
+type WyHashDigest struct {
+	initialSeed      uint64
+	seed, see1, see2 uint64
+	total            uint64             // Number of bytes written
+	window           [16 + wyBlock]byte // The last 16 bytes of the previous block followed by the pending bytes
+	buffered         int                // Number of pending bytes
+}
+
+// Ensure WyHashDigest implements Digest
+var _ Digest = (*WyHashDigest)(nil)
+
+// NewWyHashDigest creates a new streaming wyhash computation with the given seed
+func NewWyHashDigest(seed uint64) *WyHashDigest {
+	digest := &WyHashDigest{}
+	digest.reset(seed)
+	return digest
+}
+
+// reset starts a new computation with the given seed
+func (d *WyHashDigest) reset(seed uint64) {
+	mixed := seed ^ wyMix(seed^wySecret[0], wySecret[1])
+	*d = WyHashDigest{initialSeed: seed, seed: mixed, see1: mixed, see2: mixed}
+}
+
+// Reset discards the input written so far, keeping the seed
+func (d *WyHashDigest) Reset() {
+	d.reset(d.initialSeed)
+}
+
+// Size returns the number of bytes Sum appends
+func (d *WyHashDigest) Size() int {
+	return 8
+}
+
+// BlockSize returns the number of bytes the algorithm consumes at a time
+func (d *WyHashDigest) BlockSize() int {
+	return wyBlock
+}
+
+// Write appends p to the input, it never fails
+func (d *WyHashDigest) Write(p []byte) (int, error) {
+	wyWrite(d, p)
+	return len(p), nil
+}
+
+// WriteString appends the bytes of s to the input, it never fails
+func (d *WyHashDigest) WriteString(s string) (int, error) {
+	wyWrite(d, s)
+	return len(s), nil
+}
+
+// WriteByte appends a single byte to the input, it never fails
+func (d *WyHashDigest) WriteByte(b byte) error {
+	if d.buffered == wyBlock {
+		d.flush()
+	}
+	d.total++
+	d.window[16+d.buffered] = b
+	d.buffered++
+	return nil
+}
+
+// WriteUint64 appends the little endian bytes of value to the input
+func (d *WyHashDigest) WriteUint64(value uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], value)
+	wyWrite(d, buf[:])
+}
+
+// Sum appends the big endian hash of the input to b
+func (d *WyHashDigest) Sum(b []byte) []byte {
+	return binary.BigEndian.AppendUint64(b, d.Sum64())
+}
+
+// Sum64 returns the hash of the input written so far, more input can still be written afterwards
+func (d *WyHashDigest) Sum64() uint64 {
+	seed := d.seed
+	var a, b uint64
+
+	if d.total <= 16 {
+		// Short inputs never fill a block, so they are entirely pending
+		p := d.window[16 : 16+d.buffered]
+		switch n := len(p); {
+		case n >= 4:
+			shift := (n >> 3) << 2
+			a = uint64(readUint32(p))<<32 | uint64(readUint32(p[shift:]))
+			b = uint64(readUint32(p[n-4:]))<<32 | uint64(readUint32(p[n-4-shift:]))
+		case n > 0:
+			a = uint64(p[0])<<16 | uint64(p[n>>1])<<8 | uint64(p[n-1])
+		}
+	} else {
+		if d.total > wyBlock {
+			seed ^= d.see1 ^ d.see2
+		}
+		p, remaining := d.window[:16+d.buffered], d.buffered
+		for offset := 16; remaining > 16; offset, remaining = offset+16, remaining-16 {
+			seed = wyMix(readUint64(p[offset:])^wySecret[1], readUint64(p[offset+8:])^seed)
+		}
+		a = readUint64(p[len(p)-16:])
+		b = readUint64(p[len(p)-8:])
+	}
+
+	a ^= wySecret[1]
+	b ^= seed
+	a, b = wyMum(a, b)
+	return wyMix(a^wySecret[0]^d.total, b^wySecret[1])
+}
+
+// writeKey feeds a key decomposed by basicKey into the digest
+func (d *WyHashDigest) writeKey(tag byte, bits uint64, text string) {
+	_ = d.WriteByte(tag)
+	if tag == tagString {
+		_, _ = d.WriteString(text)
+	} else {
+		d.WriteUint64(bits)
+	}
+}
+
+// flush consumes the full block of pending bytes and keeps its last 16 bytes
+func (d *WyHashDigest) flush() {
+	wyBlocks(d, d.window[16:])
+	copy(d.window[:16], d.window[wyBlock:])
+	d.buffered = 0
+}
+
+// wyWrite appends p to the input of the digest, consuming whole blocks straight from p
+// as long as more input follows them
+func wyWrite[S byteSeq](d *WyHashDigest, p S) {
+	d.total += uint64(len(p))
+	if d.buffered > 0 {
+		n := copy(d.window[16+d.buffered:], p)
+		d.buffered += n
+		p = p[n:]
+		if len(p) == 0 {
+			return
+		}
+		// The pending block is full and more input follows it
+		d.flush()
+	}
+
+	if len(p) > wyBlock {
+		whole := (len(p) - 1) / wyBlock * wyBlock
+		wyBlocks(d, p[:whole])
+		copy(d.window[:16], p[whole-16:whole])
+		p = p[whole:]
+	}
+	d.buffered = copy(d.window[16:], p)
+}
+
+// wyBlocks mixes whole blocks of input into the three lanes of the digest
+func wyBlocks[S byteSeq](d *WyHashDigest, p S) {
+	seed, see1, see2 := d.seed, d.see1, d.see2
+	for ; len(p) >= wyBlock; p = p[wyBlock:] {
+		seed = wyMix(readUint64(p)^wySecret[1], readUint64(p[8:])^seed)
+		see1 = wyMix(readUint64(p[16:])^wySecret[2], readUint64(p[24:])^see1)
+		see2 = wyMix(readUint64(p[32:])^wySecret[3], readUint64(p[40:])^see2)
+	}
+	d.seed, d.see1, d.see2 = seed, see1, see2
+}
+
+// wyMum returns the low and high halves of the 128-bit product of a and b
+func wyMum(a, b uint64) (uint64, uint64) {
+	hi, lo := bits.Mul64(a, b)
+	return lo, hi
+}
+
+// wyMix folds the 128-bit product of a and b into 64 bits
+func wyMix(a, b uint64) uint64 {
+	lo, hi := wyMum(a, b)
+	return lo ^ hi
+}
@@ -0,0 +1,120 @@
+package hashing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWyHash_ReferenceVectors(t *testing.T) {
+	// The test vectors of wyhash final version 4, each hashed with its index as the seed
+	vectors := []struct {
+		input    string
+		expected uint64
+	}{
+		{"", 0x93228a4de0eec5a2},
+		{"a", 0xc5bac3db178713c4},
+		{"abc", 0xa97f2f7b1d9b3314},
+		{"message digest", 0x786d1f1df3801df4},
+		{"abcdefghijklmnopqrstuvwxyz", 0xdca5a8138ad37c87},
+		{"ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789", 0xb9e734f117cfaf70},
+		{"12345678901234567890123456789012345678901234567890123456789012345678901234567890", 0x6cc5eab49a92d617},
+	}
+
+	for seed, vector := range vectors {
+		digest := NewWyHashDigest(uint64(seed))
+		_, _ = digest.WriteString(vector.input)
+		assert.Equal(t, vector.expected, digest.Sum64(), "Unexpected wyhash of %q", vector.input)
+	}
+	// Inputs of whole blocks, whose last block goes through the final steps rather than the block loop, hashed with seed 7
+	blocks := map[int]uint64{48: 0x4b8f527c95882b62, 96: 0xb906d72c05216af7}
+	for n, expected := range blocks {
+		input := strings.Repeat("1234567890", 10)[:n]
+		digest := NewWyHashDigest(7)
+		_, _ = digest.WriteString(input)
+		assert.Equal(t, expected, digest.Sum64(), "Unexpected wyhash of %d bytes", n)
+	}
+}
+
+// wyHashReference is a direct port of the one-shot wyhash function of final version 4, the digest must match it
+func wyHashReference(p []byte, seed uint64) uint64 {
+	n := len(p)
+	seed ^= wyMix(seed^wySecret[0], wySecret[1])
+	var a, b uint64
+	if n <= 16 {
+		if n >= 4 {
+			shift := (n >> 3) << 2
+			a = uint64(readUint32(p))<<32 | uint64(readUint32(p[shift:]))
+			b = uint64(readUint32(p[n-4:]))<<32 | uint64(readUint32(p[n-4-shift:]))
+		} else if n > 0 {
+			a = uint64(p[0])<<16 | uint64(p[n>>1])<<8 | uint64(p[n-1])
+		}
+	} else {
+		offset, i := 0, n
+		if i > 48 {
+			see1, see2 := seed, seed
+			for {
+				seed = wyMix(readUint64(p[offset:])^wySecret[1], readUint64(p[offset+8:])^seed)
+				see1 = wyMix(readUint64(p[offset+16:])^wySecret[2], readUint64(p[offset+24:])^see1)
+				see2 = wyMix(readUint64(p[offset+32:])^wySecret[3], readUint64(p[offset+40:])^see2)
+				offset, i = offset+48, i-48
+				if i <= 48 {
+					break
+				}
+			}
+			seed ^= see1 ^ see2
+		}
+		for ; i > 16; offset, i = offset+16, i-16 {
+			seed = wyMix(readUint64(p[offset:])^wySecret[1], readUint64(p[offset+8:])^seed)
+		}
+		// The last 16 bytes of the input, which may overlap the ones consumed above
+		a = readUint64(p[offset+i-16:])
+		b = readUint64(p[offset+i-8:])
+	}
+	a ^= wySecret[1]
+	b ^= seed
+	a, b = wyMum(a, b)
+	return wyMix(a^wySecret[0]^uint64(n), b^wySecret[1])
+}
+
+func TestWyHash_StreamingMatchesOneShot(t *testing.T) {
+	input := make([]byte, 200)
+	for i := range input {
+		input[i] = byte(i*7 + 1)
+	}
+
+	for n := range len(input) + 1 {
+		expected := wyHashReference(input[:n], 42)
+
+		whole := NewWyHashDigest(42)
+		_, _ = whole.Write(input[:n])
+		assert.Equal(t, expected, whole.Sum64(), "Unexpected hash of %d bytes written at once", n)
+
+		bytewise := NewWyHashDigest(42)
+		for _, b := range input[:n] {
+			_ = bytewise.WriteByte(b)
+		}
+		assert.Equal(t, expected, bytewise.Sum64(), "Unexpected hash of %d bytes written one at a time", n)
+
+		chunked := NewWyHashDigest(42)
+		for rest := input[:n]; len(rest) > 0; {
+			size := min(len(rest), 13)
+			_, _ = chunked.Write(rest[:size])
+			rest = rest[size:]
+		}
+		assert.Equal(t, expected, chunked.Sum64(), "Unexpected hash of %d bytes written in chunks", n)
+	}
+}
+
+func TestWyHash_Keys(t *testing.T) {
+	hasher := NewWyHash[any](0)
+	assert.Equal(t, hasher.Hash("key"), hasher.Hash("key"), "Equal keys should hash the same")
+	assert.NotEqual(t, hasher.Hash(int64(1)), hasher.Hash(uint64(1)), "Equal bits of different types should not collide")
+	assert.NotEqual(t, hasher.Hash("key"), NewWyHash[any](1).Hash("key"), "The seed should change the hashes")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		hasher.Hash("a key long enough to span a whole block of wyhash input")
+	})
+	assert.Zero(t, allocs, "Hashing should not allocate")
+}
@@ -0,0 +1,218 @@
+package hashing
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+const (
+	xxPrime1 uint64 = 11400714785074694791
+	xxPrime2 uint64 = 14029467366897019727
+	xxPrime3 uint64 = 1609587929392839161
+	xxPrime4 uint64 = 9650029242287828579
+	xxPrime5 uint64 = 2870177450012600261
+
+	// xxStripe is the number of bytes xxHash64 consumes at a time
+	xxStripe = 32
+)
+
+// XXHash64 implements the 64-bit xxHash algorithm, a fast non-cryptographic hash with good distribution
+type XXHash64[K comparable] struct {
+	seed uint64
+}
+
+// Ensure XXHash64 implements HashFunction
+var _ HashFunction[int] = (*XXHash64[int])(nil)
+
+// NewXXHash64 creates a new xxHash64 hash function with the given seed
+func NewXXHash64[K comparable](seed uint64) *XXHash64[K] {
+	return &XXHash64[K]{seed: seed}
+}
+
+// Hash computes the xxHash64 of the key without allocating.
+// Strings, booleans, integers and floats are hashed along with a tag for their type,
+// any other key is first hashed by maphash.Comparable, whose results are only stable within a process,
+// and that hash is then hashed under the seed.
+func (x *XXHash64[K]) Hash(key K) uint64 {
+	tag, bits, text := seedableKey(key)
+
+	var digest XXHash64Digest
+	digest.reset(x.seed)
+	digest.writeKey(tag, bits, text)
+	return digest.Sum64()
+}
+
+// XXHash64Digest is a streaming xxHash64 computation
+type XXHash64Digest struct {
+	seed           uint64
+	v1, v2, v3, v4 uint64
+	total          uint64         // Number of bytes written
+	pending        [xxStripe]byte // Bytes written since the last complete stripe
+	buffered       int            // Number of bytes in pending
+}
+
+// Ensure XXHash64Digest implements Digest
+var _ Digest = (*XXHash64Digest)(nil)
+
+// NewXXHash64Digest creates a new streaming xxHash64 computation with the given seed
+func NewXXHash64Digest(seed uint64) *XXHash64Digest {
+	digest := &XXHash64Digest{}
+	digest.reset(seed)
+	return digest
+}
+
+// reset starts a new computation with the given seed
+func (d *XXHash64Digest) reset(seed uint64) {
+	*d = XXHash64Digest{
+		seed: seed,
+		v1:   seed + xxPrime1 + xxPrime2,
+		v2:   seed + xxPrime2,
+		v3:   seed,
+		v4:   seed - xxPrime1,
+	}
+}
+
+// Reset discards the input written so far, keeping the seed
+func (d *XXHash64Digest) Reset() {
+	d.reset(d.seed)
+}
+
+// Size returns the number of bytes Sum appends
+func (d *XXHash64Digest) Size() int {
+	return 8
+}
+
+// BlockSize returns the number of bytes the algorithm consumes at a time
+func (d *XXHash64Digest) BlockSize() int {
+	return xxStripe
+}
+
+// Write appends p to the input, it never fails
+func (d *XXHash64Digest) Write(p []byte) (int, error) {
+	xxWrite(d, p)
+	return len(p), nil
+}
+
+// WriteString appends the bytes of s to the input, it never fails
+func (d *XXHash64Digest) WriteString(s string) (int, error) {
+	xxWrite(d, s)
+	return len(s), nil
+}
+
+// WriteByte appends a single byte to the input, it never fails
+func (d *XXHash64Digest) WriteByte(b byte) error {
+	d.total++
+	d.pending[d.buffered] = b
+	d.buffered++
+	if d.buffered == xxStripe {
+		xxStripes(d, d.pending[:])
+		d.buffered = 0
+	}
+	return nil
+}
+
+// WriteUint64 appends the little endian bytes of value to the input
+func (d *XXHash64Digest) WriteUint64(value uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], value)
+	xxWrite(d, buf[:])
+}
+
+// Sum appends the big endian hash of the input to b
+func (d *XXHash64Digest) Sum(b []byte) []byte {
+	return binary.BigEndian.AppendUint64(b, d.Sum64())
+}
+
+// Sum64 returns the hash of the input written so far, more input can still be written afterwards
+func (d *XXHash64Digest) Sum64() uint64 {
+	var hash uint64
+	if d.total >= xxStripe {
+		hash = bits.RotateLeft64(d.v1, 1) + bits.RotateLeft64(d.v2, 7) + bits.RotateLeft64(d.v3, 12) + bits.RotateLeft64(d.v4, 18)
+		hash = xxMergeRound(hash, d.v1)
+		hash = xxMergeRound(hash, d.v2)
+		hash = xxMergeRound(hash, d.v3)
+		hash = xxMergeRound(hash, d.v4)
+	} else {
+		hash = d.seed + xxPrime5
+	}
+	hash += d.total
+
+	p := d.pending[:d.buffered]
+	for ; len(p) >= 8; p = p[8:] {
+		hash ^= xxRound(0, readUint64(p))
+		hash = bits.RotateLeft64(hash, 27)*xxPrime1 + xxPrime4
+	}
+	if len(p) >= 4 {
+		hash ^= uint64(readUint32(p)) * xxPrime1
+		hash = bits.RotateLeft64(hash, 23)*xxPrime2 + xxPrime3
+		p = p[4:]
+	}
+	for _, b := range p {
+		hash ^= uint64(b) * xxPrime5
+		hash = bits.RotateLeft64(hash, 11) * xxPrime1
+	}
+
+	hash ^= hash >> 33
+	hash *= xxPrime2
+	hash ^= hash >> 29
+	hash *= xxPrime3
+	hash ^= hash >> 32
+	return hash
+}
+
+// writeKey feeds a key decomposed by basicKey into the digest
+func (d *XXHash64Digest) writeKey(tag byte, bits uint64, text string) {
+	_ = d.WriteByte(tag)
+	if tag == tagString {
+		_, _ = d.WriteString(text)
+	} else {
+		d.WriteUint64(bits)
+	}
+}
+
+// xxWrite appends p to the input of the digest, consuming whole stripes straight from p
+func xxWrite[S byteSeq](d *XXHash64Digest, p S) {
+	d.total += uint64(len(p))
+	if d.buffered > 0 {
+		n := copy(d.pending[d.buffered:], p)
+		d.buffered += n
+		p = p[n:]
+		if d.buffered < xxStripe {
+			return
+		}
+		xxStripes(d, d.pending[:])
+		d.buffered = 0
+	}
+
+	if len(p) >= xxStripe {
+		whole := len(p) &^ (xxStripe - 1)
+		xxStripes(d, p[:whole])
+		p = p[whole:]
+	}
+	d.buffered = copy(d.pending[:], p)
+}
+
+// xxStripes mixes whole stripes of input into the accumulators
+func xxStripes[S byteSeq](d *XXHash64Digest, p S) {
+	v1, v2, v3, v4 := d.v1, d.v2, d.v3, d.v4
+	for ; len(p) >= xxStripe; p = p[xxStripe:] {
+		v1 = xxRound(v1, readUint64(p))
+		v2 = xxRound(v2, readUint64(p[8:]))
+		v3 = xxRound(v3, readUint64(p[16:]))
+		v4 = xxRound(v4, readUint64(p[24:]))
+	}
+	d.v1, d.v2, d.v3, d.v4 = v1, v2, v3, v4
+}
+
+// xxRound mixes 8 bytes of input into an accumulator
+func xxRound(acc, input uint64) uint64 {
+	acc += input * xxPrime2
+	acc = bits.RotateLeft64(acc, 31)
+	return acc * xxPrime1
+}
+
+// xxMergeRound folds an accumulator into the hash
+func xxMergeRound(hash, acc uint64) uint64 {
+	hash ^= xxRound(0, acc)
+	return hash*xxPrime1 + xxPrime4
+}
@@ -0,0 +1,37 @@
+package hashing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestXXHash64_ReferenceVectors(t *testing.T) {
+	vectors := []struct {
+		input    string
+		expected uint64
+	}{
+		{"", 0xef46db3751d8e999},
+		{"a", 0xd24ec4f1a98c6e5b},
+		{"abc", 0x44bc2cf5ad770999},
+		{"Nobody inspects the spammish repetition", 0xfbcea83c8a378bf1},
+	}
+
+	for _, vector := range vectors {
+		digest := NewXXHash64Digest(0)
+		_, _ = digest.WriteString(vector.input)
+		assert.Equal(t, vector.expected, digest.Sum64(), "Unexpected xxHash64 of %q", vector.input)
+	}
+}
+
+func TestXXHash64_Keys(t *testing.T) {
+	hasher := NewXXHash64[any](0)
+	assert.Equal(t, hasher.Hash("key"), hasher.Hash("key"), "Equal keys should hash the same")
+	assert.NotEqual(t, hasher.Hash(int64(1)), hasher.Hash(uint64(1)), "Equal bits of different types should not collide")
+	assert.NotEqual(t, hasher.Hash("key"), NewXXHash64[any](1).Hash("key"), "The seed should change the hashes")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		hasher.Hash("a key long enough to span a stripe of xxHash64")
+	})
+	assert.Zero(t, allocs, "Hashing should not allocate")
+}
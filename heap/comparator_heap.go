@@ -0,0 +1,78 @@
+package heap
+
+import (
+	"container/heap"
+
+	"github.com/jorge-barroso/collections"
+)
+
+// ComparatorHeap implements heap.Interface for values ordered by a Comparator, the first value in that order is popped first
+type ComparatorHeap[T any] struct {
+	items   []T
+	compare collections.Comparator[T]
+}
+
+var _ heap.Interface = &ComparatorHeap[int]{}
+
+// NewComparatorHeap creates a new heap adapter with the given capacity, ordering its values with compare
+func NewComparatorHeap[T any](capacity int, compare collections.Comparator[T]) *ComparatorHeap[T] {
+	return &ComparatorHeap[T]{
+		items:   make([]T, 0, capacity),
+		compare: compare,
+	}
+}
+
+// Len returns the number of elements in the heap
+func (h *ComparatorHeap[T]) Len() int {
+	return len(h.items)
+}
+
+// Less defines the ordering of elements
+func (h *ComparatorHeap[T]) Less(i, j int) bool {
+	return h.compare(h.items[i], h.items[j]) < 0
+}
+
+// Swap exchanges elements at the given indices
+func (h *ComparatorHeap[T]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+// Push adds an element to the heap
+func (h *ComparatorHeap[T]) Push(x any) {
+	h.items = append(h.items, x.(T))
+}
+
+// Pop removes and returns the last element
+func (h *ComparatorHeap[T]) Pop() any {
+	n := len(h.items)
+	element := h.items[n-1]
+	var zero T
+	h.items[n-1] = zero // avoid memory leak
+	h.items = h.items[:n-1]
+	return element
+}
+
+// Peek returns the element that would be popped next without removing it
+func (h *ComparatorHeap[T]) Peek() (T, bool) {
+	if len(h.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.items[0], true
+}
+
+// Fix updates the position of the element at index i
+func (h *ComparatorHeap[T]) Fix(i int) {
+	heap.Fix(h, i)
+}
+
+// Clear removes all elements from the heap
+func (h *ComparatorHeap[T]) Clear() {
+	clear(h.items)
+	h.items = h.items[:0]
+}
+
+// Initialize sets up the heap
+func (h *ComparatorHeap[T]) Initialize() {
+	heap.Init(h)
+}
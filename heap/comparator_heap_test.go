@@ -0,0 +1,54 @@
+package heap
+
+import (
+	"container/heap"
+	"testing"
+
+	"github.com/jorge-barroso/collections"
+	"github.com/stretchr/testify/assert"
+)
+
+type task struct {
+	name     string
+	priority int
+}
+
+func TestComparatorHeap_HeapOperations(t *testing.T) {
+	byPriority := collections.Comparing(func(t task) int { return t.priority }).Reversed()
+	h := NewComparatorHeap(4, byPriority.ThenComparing(collections.Comparing(func(t task) string { return t.name })))
+	h.Initialize()
+
+	_, ok := h.Peek()
+	assert.False(t, ok, "An empty heap should have nothing to peek at")
+
+	heap.Push(h, task{"write", 1})
+	heap.Push(h, task{"review", 3})
+	heap.Push(h, task{"deploy", 3})
+	heap.Push(h, task{"plan", 2})
+	assert.Equal(t, 4, h.Len(), "Expected every pushed element to be in the heap")
+
+	next, ok := h.Peek()
+	assert.True(t, ok, "A non-empty heap should have an element to peek at")
+	assert.Equal(t, "deploy", next.name, "Peek should return the first element in comparator order")
+
+	var names []string
+	for h.Len() > 0 {
+		names = append(names, heap.Pop(h).(task).name)
+	}
+	assert.Equal(t, []string{"deploy", "review", "plan", "write"}, names, "Elements should be popped in comparator order")
+}
+
+func TestComparatorHeap_FixAndClear(t *testing.T) {
+	h := NewComparatorHeap(0, collections.NaturalOrder[int]())
+	for _, value := range []int{5, 3, 8} {
+		heap.Push(h, value)
+	}
+
+	h.items[2] = 1
+	h.Fix(2)
+	next, _ := h.Peek()
+	assert.Equal(t, 1, next, "Fix should restore the heap order after an element changes")
+
+	h.Clear()
+	assert.Equal(t, 0, h.Len(), "Expected the heap to be empty after Clear()")
+}
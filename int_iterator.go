@@ -1,5 +1,27 @@
 package collections
 
+// Iterator walks over the elements of a collection using a cursor.
+//
+// Every implementation follows the same contract:
+//   - Next moves the cursor onto the following element and reports whether there was one.
+//   - Value returns the element under the cursor without moving it, so calling it repeatedly
+//     returns the same element.
+//   - Before the first call to Next, or if Next never found an element, Value returns ErrNoSuchElement.
+//   - Once Next returns false the cursor stays on the last element visited, and later calls
+//     to Next keep returning false.
+//
+// Iterators over collections that are not safe for concurrent use are fail-fast: once the
+// collection is structurally modified by anything other than the iterator itself, Value returns
+// ErrConcurrentModification. Next stops advancing at that point, returning true one last time so
+// the loop body gets to see the error, and false afterwards.
+//
+// The expected usage is therefore:
+//
+//	it := iterable.NewIterator()
+//	for it.Next() {
+//		value, err := it.Value()
+//		...
+//	}
 type Iterator[T any] interface {
 	Next() bool
 	Value() (T, error)
@@ -0,0 +1,12 @@
+package collections
+
+// MutableIterator is an Iterator that can also remove the element it is positioned on
+// from the underlying collection.
+//
+// After a successful Remove the cursor sits between the neighbours of the removed element:
+// Value and Remove return ErrNoSuchElement until Next moves onto the following element.
+// Removing through the iterator is not treated as a concurrent modification.
+type MutableIterator[T any] interface {
+	Iterator[T]
+	Remove() error
+}
@@ -0,0 +1,20 @@
+package collections
+
+// Spliterator traverses a range of the elements of a collection, and can hand part of that
+// range over to a new Spliterator so that both parts can be processed in parallel.
+//
+// A Spliterator is not safe for concurrent use, but the Spliterators split off one another
+// can each be used from a different goroutine. The collection must not be structurally
+// modified while any of them is in use, unless it works on a snapshot.
+type Spliterator[T any] interface {
+	// TryAdvance calls action on the next element, reporting whether there was one
+	TryAdvance(action func(T)) bool
+	// ForEachRemaining calls action on every element left in the range, in order
+	ForEachRemaining(action func(T))
+	// TrySplit moves the first part of the remaining range to a new Spliterator and returns it,
+	// or returns nil if the range cannot be split. The elements of the returned Spliterator
+	// always come before the ones left in this one.
+	TrySplit() Spliterator[T]
+	// EstimateSize returns an estimate of the number of elements left in the range
+	EstimateSize() int
+}
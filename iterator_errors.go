@@ -0,0 +1,13 @@
+package collections
+
+import "errors"
+
+var (
+	// ErrNoSuchElement is returned by Iterator.Value when the iterator is not positioned on an element,
+	// either because Next has not been called yet or because the collection had no elements to visit
+	ErrNoSuchElement = errors.New("no more elements")
+
+	// ErrConcurrentModification is returned by fail-fast iterators when the collection they walk
+	// has been structurally modified since the iterator was created
+	ErrConcurrentModification = errors.New("collection was modified during iteration")
+)
@@ -1,6 +1,9 @@
 package lists
 
 import (
+	"iter"
+	"slices"
+
 	"github.com/jorge-barroso/collections"
 )
 
@@ -8,11 +11,13 @@ import (
 type ArrayList[T any] struct {
 	listOps[T]
 	elements []T
+	modCount int // Number of structural modifications, used by iterators to fail fast
 }
 
-// Ensure ArrayList implements both Map and Iterable interfaces
+// Ensure ArrayList implements the List, Iterable and Capacitor interfaces
 var _ List[int] = (*ArrayList[int])(nil)
 var _ collections.Iterable[int] = (*ArrayList[int])(nil)
+var _ Capacitor = (*ArrayList[int])(nil)
 
 // NewArrayListWithCapacity creates and returns a new instance of ArrayList with the desired initial capacity
 func NewArrayListWithCapacity[T any](capacity int) *ArrayList[T] {
@@ -29,6 +34,7 @@ func NewArrayList[T any]() *ArrayList[T] {
 // Add appends an item to the end of the list
 func (a *ArrayList[T]) Add(item T) {
 	a.elements = append(a.elements, item)
+	a.modCount++
 }
 
 // Remove removes element at specified index
@@ -36,7 +42,8 @@ func (a *ArrayList[T]) Remove(index int) error {
 	if err := a.validateIndex(index, a.Size()); err != nil {
 		return err
 	}
-	a.elements = append(a.elements[:index], a.elements[index+1:]...)
+	a.elements = slices.Delete(a.elements, index, index+1)
+	a.modCount++
 	return nil
 }
 
@@ -55,10 +62,228 @@ func (a *ArrayList[T]) Size() int {
 	return len(a.elements)
 }
 
+// IsEmpty reports whether the list has no elements
+func (a *ArrayList[T]) IsEmpty() bool {
+	return len(a.elements) == 0
+}
+
+// AddAll appends every given item, in order, to the end of the list
+func (a *ArrayList[T]) AddAll(items ...T) {
+	if len(items) == 0 {
+		return
+	}
+	a.elements = append(a.elements, items...)
+	a.modCount++
+}
+
+// Insert adds an item at the specified index, shifting the following elements to the right
+func (a *ArrayList[T]) Insert(index int, item T) error {
+	if err := a.validateInsertIndex(index, a.Size()); err != nil {
+		return err
+	}
+
+	a.elements = slices.Insert(a.elements, index, item)
+	a.modCount++
+	return nil
+}
+
+// Set replaces the element at the specified index
+func (a *ArrayList[T]) Set(index int, item T) error {
+	if err := a.validateIndex(index, a.Size()); err != nil {
+		return err
+	}
+
+	a.elements[index] = item
+	return nil
+}
+
+// RemoveRange removes the elements between from, inclusive, and to, exclusive
+func (a *ArrayList[T]) RemoveRange(from, to int) error {
+	if err := a.validateRange(from, to, a.Size()); err != nil {
+		return err
+	}
+	if from == to {
+		return nil
+	}
+
+	a.elements = slices.Delete(a.elements, from, to)
+	a.modCount++
+	return nil
+}
+
+// ReplaceRange replaces the elements between from, inclusive, and to, exclusive, with the given items,
+// which do not need to be as many as the elements they replace
+func (a *ArrayList[T]) ReplaceRange(from, to int, items ...T) error {
+	if err := a.validateRange(from, to, a.Size()); err != nil {
+		return err
+	}
+
+	a.elements = slices.Replace(a.elements, from, to, items...)
+	if len(items) != to-from {
+		a.modCount++
+	}
+	return nil
+}
+
+// RemoveIf removes every element matching the predicate and returns how many were removed
+func (a *ArrayList[T]) RemoveIf(predicate func(T) bool) int {
+	return a.removeIfBetween(0, a.Size(), predicate)
+}
+
+// RetainAll removes every element not equal, according to eq, to any of items and returns how many were removed
+func (a *ArrayList[T]) RetainAll(items []T, eq func(a, b T) bool) int {
+	return a.RemoveIf(func(item T) bool {
+		return a.indexOf(items, item, eq) < 0
+	})
+}
+
+// SubList returns a live view of the elements between from, inclusive, and to, exclusive.
+// Changes made through the view are visible in the list and the other way round, but once the list
+// is structurally modified other than through the view, the view fails with collections.ErrConcurrentModification,
+// returning it from methods with an error result and panicking with it from the rest.
+func (a *ArrayList[T]) SubList(from, to int) (*SubList[T], error) {
+	if err := a.validateRange(from, to, a.Size()); err != nil {
+		return nil, err
+	}
+
+	return &SubList[T]{
+		root:     a,
+		offset:   from,
+		size:     to - from,
+		modCount: a.modCount,
+	}, nil
+}
+
+// removeIfBetween removes the elements in [from, to) matching the predicate in a single pass
+// and returns how many were removed
+func (a *ArrayList[T]) removeIfBetween(from, to int, predicate func(T) bool) int {
+	kept := slices.DeleteFunc(a.elements[from:to], predicate)
+	removed := to - from - len(kept)
+	if removed == 0 {
+		return 0
+	}
+
+	// DeleteFunc compacted the window in place, close the gap it left behind
+	a.elements = slices.Delete(a.elements, from+len(kept), to)
+	a.modCount++
+	return removed
+}
+
+// IndexOf returns the index of the first element equal to item according to eq, or -1 if there is none
+func (a *ArrayList[T]) IndexOf(item T, eq func(a, b T) bool) int {
+	return a.indexOf(a.elements, item, eq)
+}
+
+// LastIndexOf returns the index of the last element equal to item according to eq, or -1 if there is none
+func (a *ArrayList[T]) LastIndexOf(item T, eq func(a, b T) bool) int {
+	return a.lastIndexOf(a.elements, item, eq)
+}
+
+// Contains reports whether the list holds an element equal to item according to eq
+func (a *ArrayList[T]) Contains(item T, eq func(a, b T) bool) bool {
+	return a.IndexOf(item, eq) >= 0
+}
+
+// Clear removes every element from the list, keeping the allocated capacity
+func (a *ArrayList[T]) Clear() {
+	// Zero the elements so the backing array does not keep them reachable
+	clear(a.elements)
+	a.elements = a.elements[:0]
+	a.modCount++
+}
+
+// ToSlice returns a copy of the elements of the list
+func (a *ArrayList[T]) ToSlice() []T {
+	result := make([]T, len(a.elements))
+	copy(result, a.elements)
+	return result
+}
+
+// Reverse reverses the order of the elements in place, which open iterators and sub-lists treat as a structural modification
+func (a *ArrayList[T]) Reverse() {
+	slices.Reverse(a.elements)
+	a.modCount++
+}
+
+// Swap exchanges the elements at the specified indices
+func (a *ArrayList[T]) Swap(i, j int) error {
+	if err := a.validateIndex(i, a.Size()); err != nil {
+		return err
+	}
+	if err := a.validateIndex(j, a.Size()); err != nil {
+		return err
+	}
+
+	a.elements[i], a.elements[j] = a.elements[j], a.elements[i]
+	return nil
+}
+
+// Sort stably sorts the elements in place according to cmp, taking advantage of the runs that are already in order.
+// Like Reverse, it counts as a structural modification for open iterators and sub-lists.
+func (a *ArrayList[T]) Sort(cmp func(a, b T) int) {
+	timSort(a.elements, cmp)
+	a.modCount++
+}
+
+// IsSorted reports whether the elements are in the order defined by cmp
+func (a *ArrayList[T]) IsSorted(cmp func(a, b T) int) bool {
+	return slices.IsSortedFunc(a.elements, cmp)
+}
+
+// BinarySearch looks for item in a list sorted according to cmp, returning the position where it was found,
+// or where it would be inserted to keep the list sorted, and whether it was found
+func (a *ArrayList[T]) BinarySearch(item T, cmp func(a, b T) int) (int, bool) {
+	return slices.BinarySearchFunc(a.elements, item, cmp)
+}
+
+// Capacity returns the number of elements the list can hold before having to grow
+func (a *ArrayList[T]) Capacity() int {
+	return cap(a.elements)
+}
+
+// EnsureCapacity grows the list, if needed, so it can hold at least capacity elements without reallocating
+func (a *ArrayList[T]) EnsureCapacity(capacity int) {
+	if capacity > cap(a.elements) {
+		a.elements = slices.Grow(a.elements, capacity-len(a.elements))
+	}
+}
+
+// TrimToSize shrinks the backing array of the list to its current size
+func (a *ArrayList[T]) TrimToSize() {
+	if cap(a.elements) > len(a.elements) {
+		trimmed := make([]T, len(a.elements))
+		copy(trimmed, a.elements)
+		a.elements = trimmed
+	}
+}
+
 // NewIterator creates and returns a new iterator for the ArrayList.
 func (a *ArrayList[T]) NewIterator() collections.Iterator[T] {
+	return a.NewListIterator()
+}
+
+// NewListIterator returns an iterator that can also remove and replace elements of the ArrayList
+func (a *ArrayList[T]) NewListIterator() ListIterator[T] {
 	return &ArrayListIterator[T]{
-		index: -1,
-		list:  a,
+		index:            -1,
+		list:             a,
+		expectedModCount: a.modCount,
 	}
 }
+
+// All returns an iter.Seq over the elements of the list, in index order
+func (a *ArrayList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < len(a.elements); i++ {
+			if !yield(a.elements[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Spliterator returns a splittable traversal over the current elements of the list.
+// The list must not be structurally modified while the spliterator is in use.
+func (a *ArrayList[T]) Spliterator() collections.Spliterator[T] {
+	return newSliceSpliterator(a.elements)
+}
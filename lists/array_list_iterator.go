@@ -1,27 +1,86 @@
 package lists
 
-import "errors"
+import "github.com/jorge-barroso/collections"
 
 // ArrayListIterator struct for ArrayList
 type ArrayListIterator[T any] struct {
-	index int
-	list  *ArrayList[T]
+	index            int
+	list             *ArrayList[T]
+	removed          bool // Set when the element under the cursor has been removed through the iterator
+	expectedModCount int  // modCount of the list when the iterator last synchronised with it
+	failed           bool // Set once a concurrent modification has been reported by Next
 }
 
+// Ensure ArrayListIterator implements ListIterator
+var _ ListIterator[int] = (*ArrayListIterator[int])(nil)
+
+// Next advances the iterator to the next element
 func (aIt *ArrayListIterator[T]) Next() bool {
+	if aIt.modified() {
+		if aIt.failed {
+			return false
+		}
+		aIt.failed = true
+		return true
+	}
+
 	if aIt.index < len(aIt.list.elements)-1 {
 		aIt.index++
+		aIt.removed = false
 		return true
 	}
 
 	return false
 }
 
+// Value returns the element the iterator is positioned on
 func (aIt *ArrayListIterator[T]) Value() (T, error) {
-	if aIt.index < 0 {
-		var zero T
-		return zero, errors.New("out of bounds, call Next() first")
+	var zero T
+	if err := aIt.checkPositioned(); err != nil {
+		return zero, err
 	}
 
 	return aIt.list.elements[aIt.index], nil
 }
+
+// Remove deletes the element the iterator is positioned on, shifting the following elements left
+func (aIt *ArrayListIterator[T]) Remove() error {
+	if err := aIt.checkPositioned(); err != nil {
+		return err
+	}
+
+	if err := aIt.list.Remove(aIt.index); err != nil {
+		return err
+	}
+	// The next element has shifted into the current index
+	aIt.index--
+	aIt.removed = true
+	aIt.expectedModCount = aIt.list.modCount
+	return nil
+}
+
+// Set replaces the element the iterator is positioned on
+func (aIt *ArrayListIterator[T]) Set(value T) error {
+	if err := aIt.checkPositioned(); err != nil {
+		return err
+	}
+
+	aIt.list.elements[aIt.index] = value
+	return nil
+}
+
+// checkPositioned returns an error unless the iterator sits on a valid, unmodified element
+func (aIt *ArrayListIterator[T]) checkPositioned() error {
+	if aIt.modified() {
+		return collections.ErrConcurrentModification
+	}
+	if aIt.index < 0 || aIt.removed {
+		return collections.ErrNoSuchElement
+	}
+	return nil
+}
+
+// modified reports whether the list has been structurally modified behind the iterator
+func (aIt *ArrayListIterator[T]) modified() bool {
+	return aIt.list.modCount != aIt.expectedModCount
+}
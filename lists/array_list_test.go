@@ -1,8 +1,11 @@
 package lists
 
 import (
+	"slices"
 	"testing"
 
+	"github.com/jorge-barroso/collections"
+	"github.com/jorge-barroso/collections/collectionstest"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -96,3 +99,87 @@ func TestArrayListIterator_Value(t *testing.T) {
 	_, err = iter.Value()
 	assert.Equal(t, 2, value, "Value mismatch for the second iterator position, Next() should not have moved past the last position")
 }
+
+func TestArrayList_All(t *testing.T) {
+	list := NewArrayList[int]()
+	assert.Empty(t, slices.Collect(list.All()), "Expected no elements for an empty list")
+
+	list.Add(1)
+	list.Add(2)
+	list.Add(3)
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(list.All()), "Elements mismatch when ranging over the list")
+
+	var seen []int
+	for v := range list.All() {
+		seen = append(seen, v)
+		if v == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{1, 2}, seen, "Expected the loop to stop at the break")
+}
+
+func TestArrayList_IteratorConformance(t *testing.T) {
+	list := NewArrayList[int]()
+	collectionstest.TestIterable[int](t, list, nil)
+
+	for _, v := range []int{1, 2, 3} {
+		list.Add(v)
+	}
+	collectionstest.TestIterable[int](t, list, []int{1, 2, 3})
+}
+
+func TestArrayListIterator_FailFast(t *testing.T) {
+	list := NewArrayList[int]()
+	for _, v := range []int{1, 2, 3} {
+		list.Add(v)
+	}
+	collectionstest.TestFailFastIterable[int](t, list, func() { list.Add(4) })
+
+	// Removing behind the iterator must not silently shift the elements it reads
+	iter := list.NewIterator()
+	iter.Next()
+	assert.NoError(t, list.Remove(0), "Unexpected error when removing element at index 0")
+	_, err := iter.Value()
+	assert.ErrorIs(t, err, collections.ErrConcurrentModification, "Expected the removal to be detected")
+}
+
+func TestArrayListIterator_RemoveAndSet(t *testing.T) {
+	list := NewArrayList[int]()
+	for i := 1; i <= 6; i++ {
+		list.Add(i)
+	}
+
+	iter := list.NewListIterator()
+	assert.ErrorIs(t, iter.Remove(), collections.ErrNoSuchElement, "Remove() before Next() should fail")
+
+	for iter.Next() {
+		value, err := iter.Value()
+		assert.NoError(t, err, "Unexpected error during iteration")
+		if value%2 == 0 {
+			assert.NoError(t, iter.Remove(), "Unexpected error when removing through the iterator")
+			assert.ErrorIs(t, iter.Remove(), collections.ErrNoSuchElement, "Removing twice should fail")
+			_, err = iter.Value()
+			assert.ErrorIs(t, err, collections.ErrNoSuchElement, "Value() should fail after Remove()")
+		} else {
+			assert.NoError(t, iter.Set(value*10), "Unexpected error when setting through the iterator")
+		}
+	}
+
+	assert.Equal(t, []int{10, 30, 50}, slices.Collect(list.All()), "Elements mismatch after filtering through the iterator")
+}
+
+func TestArrayList_Capacity(t *testing.T) {
+	list := NewArrayListWithCapacity[int](2)
+	assert.Equal(t, 2, list.Capacity(), "Initial capacity mismatch")
+
+	list.EnsureCapacity(100)
+	assert.GreaterOrEqual(t, list.Capacity(), 100, "EnsureCapacity() should grow the list")
+	list.EnsureCapacity(10)
+	assert.GreaterOrEqual(t, list.Capacity(), 100, "EnsureCapacity() should never shrink the list")
+
+	list.AddAll(1, 2, 3)
+	list.TrimToSize()
+	assert.Equal(t, 3, list.Capacity(), "TrimToSize() should shrink the capacity to the size")
+	assert.Equal(t, []int{1, 2, 3}, list.ToSlice(), "TrimToSize() should keep the elements")
+}
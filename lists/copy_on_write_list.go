@@ -1,16 +1,22 @@
 package lists
 
 import (
-	"github.com/jorge-barroso/collections"
+	"iter"
+	"slices"
 	"sync"
+	"sync/atomic"
+
+	"github.com/jorge-barroso/collections"
 )
 
 // CopyOnWriteList is a thread-safe list implementation that creates a new
-// copy of the underlying array whenever the list is modified
+// copy of the underlying array whenever the list is modified.
+// Every array is published atomically and never modified afterwards, so reads, Size and
+// iterator creation never block, while writers are serialised by a lock.
 type CopyOnWriteList[T any] struct {
 	listOps[T]
-	lock     sync.Mutex
-	elements []T
+	lock     sync.Mutex          // Serialises writers, readers never take it
+	elements atomic.Pointer[[]T] // Current array, immutable once published
 }
 
 // Ensure CopyOnWriteList implements both Map and Iterable interfaces
@@ -19,9 +25,9 @@ var _ collections.Iterable[int] = (*CopyOnWriteList[int])(nil)
 
 // NewCopyOnWriteListWithCapacity creates a new CopyOnWriteList instance with the desired initial capacity
 func NewCopyOnWriteListWithCapacity[T any](capacity int) *CopyOnWriteList[T] {
-	return &CopyOnWriteList[T]{
-		elements: make([]T, 0, capacity),
-	}
+	list := &CopyOnWriteList[T]{}
+	list.publish(make([]T, 0, capacity))
+	return list
 }
 
 // NewCopyOnWriteList creates a new CopyOnWriteList instance
@@ -31,17 +37,7 @@ func NewCopyOnWriteList[T any]() *CopyOnWriteList[T] {
 
 // Add appends an item to the end of the list
 func (c *CopyOnWriteList[T]) Add(item T) {
-	c.lock.Lock()
-	defer c.lock.Unlock()
-
-	// Create a new slice with one more capacity
-	newElements := make([]T, len(c.elements)+1)
-	// Copy existing elements
-	copy(newElements, c.elements)
-	// Add new element
-	newElements[len(c.elements)] = item
-	// Replace old slice with new one
-	c.elements = newElements
+	c.AddAll(item)
 }
 
 // Remove removes an item at the specified index
@@ -49,47 +45,280 @@ func (c *CopyOnWriteList[T]) Remove(index int) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if err := c.validateIndex(index, c.Size()); err != nil {
+	elements := c.snapshot()
+	if err := c.validateIndex(index, len(elements)); err != nil {
 		return err
 	}
 
 	// Create new slice with one less capacity
-	newElements := make([]T, len(c.elements)-1)
+	newElements := make([]T, len(elements)-1)
 	// Copy elements before index
-	copy(newElements, c.elements[:index])
+	copy(newElements, elements[:index])
 	// Copy elements after index
-	copy(newElements[index:], c.elements[index+1:])
+	copy(newElements[index:], elements[index+1:])
 	// Replace old slice with new one
-	c.elements = newElements
+	c.publish(newElements)
 	return nil
 }
 
 // Get retrieves an element by its index
 func (c *CopyOnWriteList[T]) Get(index int) (T, error) {
-	// No lock needed for reads
-	if err := c.validateIndex(index, c.Size()); err != nil {
+	// No lock needed for reads, the snapshot cannot change under us
+	elements := c.snapshot()
+	if err := c.validateIndex(index, len(elements)); err != nil {
 		var zeroValue T
 		return zeroValue, err
 	}
 
-	return c.elements[index], nil
+	return elements[index], nil
 }
 
 // Size returns the number of elements in the list
 func (c *CopyOnWriteList[T]) Size() int {
-	return len(c.elements)
+	return len(c.snapshot())
 }
 
-// NewIterator returns a new iterator for the list
-func (c *CopyOnWriteList[T]) NewIterator() collections.Iterator[T] {
-	// Create a snapshot of current elements
+// IsEmpty reports whether the list has no elements
+func (c *CopyOnWriteList[T]) IsEmpty() bool {
+	return c.Size() == 0
+}
+
+// AddAll appends every given item, in order, copying the underlying array only once
+func (c *CopyOnWriteList[T]) AddAll(items ...T) {
+	if len(items) == 0 {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	elements := c.snapshot()
+	newElements := make([]T, len(elements)+len(items))
+	copy(newElements, elements)
+	copy(newElements[len(elements):], items)
+	c.publish(newElements)
+}
+
+// Insert adds an item at the specified index, shifting the following elements to the right
+func (c *CopyOnWriteList[T]) Insert(index int, item T) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	elements := c.snapshot()
+	if err := c.validateInsertIndex(index, len(elements)); err != nil {
+		return err
+	}
+
+	newElements := make([]T, len(elements)+1)
+	copy(newElements, elements[:index])
+	newElements[index] = item
+	copy(newElements[index+1:], elements[index:])
+	c.publish(newElements)
+	return nil
+}
+
+// Set replaces the element at the specified index
+func (c *CopyOnWriteList[T]) Set(index int, item T) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	elements := c.snapshot()
+	if err := c.validateIndex(index, len(elements)); err != nil {
+		return err
+	}
+
+	newElements := slices.Clone(elements)
+	newElements[index] = item
+	c.publish(newElements)
+	return nil
+}
+
+// RemoveRange removes the elements between from, inclusive, and to, exclusive
+func (c *CopyOnWriteList[T]) RemoveRange(from, to int) error {
+	return c.ReplaceRange(from, to)
+}
+
+// ReplaceRange replaces the elements between from, inclusive, and to, exclusive, with the given items,
+// copying the underlying array only once
+func (c *CopyOnWriteList[T]) ReplaceRange(from, to int, items ...T) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	elements := c.snapshot()
+	if err := c.validateRange(from, to, len(elements)); err != nil {
+		return err
+	}
+	if from == to && len(items) == 0 {
+		return nil
+	}
+
+	newElements := make([]T, len(elements)-(to-from)+len(items))
+	copy(newElements, elements[:from])
+	copy(newElements[from:], items)
+	copy(newElements[from+len(items):], elements[to:])
+	c.publish(newElements)
+	return nil
+}
+
+// RemoveIf removes every element matching the predicate, copying the underlying array only once,
+// and returns how many were removed
+func (c *CopyOnWriteList[T]) RemoveIf(predicate func(T) bool) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	elements := c.snapshot()
+	newElements := make([]T, 0, len(elements))
+	for _, item := range elements {
+		if !predicate(item) {
+			newElements = append(newElements, item)
+		}
+	}
+
+	removed := len(elements) - len(newElements)
+	if removed > 0 {
+		c.publish(newElements)
+	}
+	return removed
+}
+
+// RetainAll removes every element not equal, according to eq, to any of items and returns how many were removed
+func (c *CopyOnWriteList[T]) RetainAll(items []T, eq func(a, b T) bool) int {
+	return c.RemoveIf(func(item T) bool {
+		return c.indexOf(items, item, eq) < 0
+	})
+}
+
+// ReplaceAll replaces every element with the result of applying operator to it, copying the underlying array only once
+func (c *CopyOnWriteList[T]) ReplaceAll(operator func(T) T) {
 	c.lock.Lock()
-	snapshot := make([]T, len(c.elements))
-	copy(snapshot, c.elements)
-	c.lock.Unlock()
+	defer c.lock.Unlock()
+
+	elements := c.snapshot()
+	if len(elements) == 0 {
+		return
+	}
 
+	newElements := make([]T, len(elements))
+	for i, item := range elements {
+		newElements[i] = operator(item)
+	}
+	c.publish(newElements)
+}
+
+// IndexOf returns the index of the first element equal to item according to eq, or -1 if there is none
+func (c *CopyOnWriteList[T]) IndexOf(item T, eq func(a, b T) bool) int {
+	return c.indexOf(c.snapshot(), item, eq)
+}
+
+// LastIndexOf returns the index of the last element equal to item according to eq, or -1 if there is none
+func (c *CopyOnWriteList[T]) LastIndexOf(item T, eq func(a, b T) bool) int {
+	return c.lastIndexOf(c.snapshot(), item, eq)
+}
+
+// Contains reports whether the list holds an element equal to item according to eq
+func (c *CopyOnWriteList[T]) Contains(item T, eq func(a, b T) bool) bool {
+	return c.IndexOf(item, eq) >= 0
+}
+
+// Clear removes every element from the list
+func (c *CopyOnWriteList[T]) Clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.publish(make([]T, 0))
+}
+
+// ToSlice returns a copy of the elements of the list
+func (c *CopyOnWriteList[T]) ToSlice() []T {
+	return slices.Clone(c.snapshot())
+}
+
+// Reverse reverses the order of the elements, publishing them as a new array
+func (c *CopyOnWriteList[T]) Reverse() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	newElements := slices.Clone(c.snapshot())
+	slices.Reverse(newElements)
+	c.publish(newElements)
+}
+
+// Swap exchanges the elements at the specified indices
+func (c *CopyOnWriteList[T]) Swap(i, j int) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	elements := c.snapshot()
+	if err := c.validateIndex(i, len(elements)); err != nil {
+		return err
+	}
+	if err := c.validateIndex(j, len(elements)); err != nil {
+		return err
+	}
+
+	newElements := slices.Clone(elements)
+	newElements[i], newElements[j] = newElements[j], newElements[i]
+	c.publish(newElements)
+	return nil
+}
+
+// Sort stably sorts the elements according to cmp, publishing them as a new array
+func (c *CopyOnWriteList[T]) Sort(cmp func(a, b T) int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	newElements := slices.Clone(c.snapshot())
+	timSort(newElements, cmp)
+	c.publish(newElements)
+}
+
+// IsSorted reports whether the elements are in the order defined by cmp
+func (c *CopyOnWriteList[T]) IsSorted(cmp func(a, b T) int) bool {
+	return slices.IsSortedFunc(c.snapshot(), cmp)
+}
+
+// BinarySearch looks for item in a list sorted according to cmp, returning the position where it was found,
+// or where it would be inserted to keep the list sorted, and whether it was found
+func (c *CopyOnWriteList[T]) BinarySearch(item T, cmp func(a, b T) int) (int, bool) {
+	return slices.BinarySearchFunc(c.snapshot(), item, cmp)
+}
+
+// snapshot returns the current elements, which writers never modify in place
+func (c *CopyOnWriteList[T]) snapshot() []T {
+	if elements := c.elements.Load(); elements != nil {
+		return *elements
+	}
+	// A zero value list has not published any array yet
+	return nil
+}
+
+// publish makes elements the current array, it must not be modified afterwards
+func (c *CopyOnWriteList[T]) publish(elements []T) {
+	c.elements.Store(&elements)
+}
+
+// NewIterator returns a new iterator over a snapshot of the list, later writes are not visible to it
+func (c *CopyOnWriteList[T]) NewIterator() collections.Iterator[T] {
+	// Published arrays are immutable, so they can be shared with the iterator without copying
 	return &CopyOnWriteListIterator[T]{
-		snapshot: snapshot,
+		snapshot: c.snapshot(),
 		index:    -1,
 	}
 }
+
+// All returns an iter.Seq over a snapshot of the list taken when iteration starts
+func (c *CopyOnWriteList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range c.snapshot() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Spliterator returns a splittable traversal over a snapshot of the list,
+// later writes to the list are not visible to it
+func (c *CopyOnWriteList[T]) Spliterator() collections.Spliterator[T] {
+	return newSliceSpliterator(c.snapshot())
+}
@@ -4,6 +4,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/jorge-barroso/collections/collectionstest"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -181,3 +182,90 @@ func TestCopyOnWriteList_IteratorConcurrency(t *testing.T) {
 	assert.GreaterOrEqual(t, list.Size(), 100, "List size should reflect concurrent modifications")
 	// Iterator behavior under concurrency is undefined for most cases so no strict assertions
 }
+
+func TestCopyOnWriteList_All(t *testing.T) {
+	list := NewCopyOnWriteList[int]()
+	list.Add(1)
+	list.Add(2)
+
+	var seen []int
+	for v := range list.All() {
+		// Writes during the loop must not affect the snapshot being ranged over
+		list.Add(v * 10)
+		seen = append(seen, v)
+	}
+	assert.Equal(t, []int{1, 2}, seen, "Ranging should only see the snapshot taken when it started")
+	assert.Equal(t, 4, list.Size(), "List size mismatch after writing during the loop")
+}
+
+func TestCopyOnWriteList_IteratorConformance(t *testing.T) {
+	list := NewCopyOnWriteList[int]()
+	collectionstest.TestIterable[int](t, list, nil)
+
+	for _, v := range []int{1, 2, 3} {
+		list.Add(v)
+	}
+	collectionstest.TestIterable[int](t, list, []int{1, 2, 3})
+}
+
+func TestCopyOnWriteList_ConcurrentReads(t *testing.T) {
+	list := NewCopyOnWriteList[int]()
+	var wg sync.WaitGroup
+
+	// Writers exercising every kind of batch mutation
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 200; i++ {
+			list.AddAll(i, i+1, i+2)
+			list.ReplaceAll(func(v int) int { return v + 1 })
+			list.RemoveIf(func(v int) bool { return v%7 == 0 })
+		}
+	}()
+
+	// Readers never take the lock, the race detector checks they only see fully published arrays
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				size := list.Size()
+				_, _ = list.Get(size - 1)
+				iter := list.NewIterator()
+				for iter.Next() {
+					_, err := iter.Value()
+					assert.NoError(t, err, "Unexpected error while iterating concurrently")
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestCopyOnWriteList_ReplaceAll(t *testing.T) {
+	list := NewCopyOnWriteList[int]()
+	list.ReplaceAll(func(v int) int { return v * 2 })
+	assert.True(t, list.IsEmpty(), "ReplaceAll() on an empty list should not add anything")
+
+	list.AddAll(1, 2, 3)
+	iter := list.NewIterator()
+	list.ReplaceAll(func(v int) int { return v * 10 })
+	assert.Equal(t, []int{10, 20, 30}, list.ToSlice(), "Elements mismatch after ReplaceAll()")
+
+	var seen []int
+	for iter.Next() {
+		value, _ := iter.Value()
+		seen = append(seen, value)
+	}
+	assert.Equal(t, []int{1, 2, 3}, seen, "Existing iterators should keep seeing their snapshot")
+}
+
+func TestCopyOnWriteList_ZeroValue(t *testing.T) {
+	var list CopyOnWriteList[int]
+	assert.True(t, list.IsEmpty(), "Expected a zero value list to be empty")
+	assert.False(t, list.NewIterator().Next(), "Expected no elements when iterating a zero value list")
+
+	list.Add(1)
+	assert.Equal(t, []int{1}, list.ToSlice(), "Elements mismatch after adding to a zero value list")
+}
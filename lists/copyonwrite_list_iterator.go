@@ -1,7 +1,7 @@
 package lists
 
 import (
-	"errors"
+	"github.com/jorge-barroso/collections"
 )
 
 // CopyOnWriteListIterator provides iteration over a snapshot of CopyOnWriteList elements
@@ -23,7 +23,7 @@ func (cIt *CopyOnWriteListIterator[T]) Next() bool {
 func (cIt *CopyOnWriteListIterator[T]) Value() (T, error) {
 	if cIt.index < 0 {
 		var zero T
-		return zero, errors.New("no more elements, call Next() first")
+		return zero, collections.ErrNoSuchElement
 	}
 	return cIt.snapshot[cIt.index], nil
 }
@@ -1,19 +1,25 @@
 package lists
 
 import (
+	"iter"
+
 	"github.com/jorge-barroso/collections"
+	"github.com/jorge-barroso/collections/queues"
 )
 
-// LinkedList represents a singly linked list
+// LinkedList represents a doubly linked list that keeps track of both its ends
 type LinkedList[T any] struct {
 	listOps[T]
-	head *collections.Node[T]
-	size int
+	head     *collections.DoublyNode[T]
+	tail     *collections.DoublyNode[T]
+	size     int
+	modCount int // Number of structural modifications, used by iterators to fail fast
 }
 
-// Ensure LinkedList implements both Map and Iterable interfaces
+// Ensure LinkedList implements List, Iterable and Deque interfaces
 var _ List[int] = (*LinkedList[int])(nil)
 var _ collections.Iterable[int] = (*LinkedList[int])(nil)
+var _ queues.Deque[int] = (*LinkedList[int])(nil)
 
 // NewLinkedList creates and returns a new instance of LinkedList
 func NewLinkedList[T any]() *LinkedList[T] {
@@ -22,24 +28,94 @@ func NewLinkedList[T any]() *LinkedList[T] {
 
 // NewIterator for LinkedList
 func (ll *LinkedList[T]) NewIterator() collections.Iterator[T] {
+	return ll.NewListIterator()
+}
+
+// NewListIterator returns an iterator that can also remove and replace elements of the LinkedList
+func (ll *LinkedList[T]) NewListIterator() ListIterator[T] {
 	return &LinkedListIterator[T]{
-		current: ll.head,
+		list:             ll,
+		next:             ll.head,
+		expectedModCount: ll.modCount,
 	}
 }
 
 // Add appends an item to the end of the list
 func (ll *LinkedList[T]) Add(value T) {
-	newNode := &collections.Node[T]{Item: value}
+	ll.AddLast(value)
+}
+
+// AddFirst inserts an item at the head of the list
+func (ll *LinkedList[T]) AddFirst(value T) {
+	ll.linkBefore(value, ll.head)
+}
+
+// AddLast appends an item to the tail of the list
+func (ll *LinkedList[T]) AddLast(value T) {
+	ll.linkBefore(value, nil)
+}
+
+// RemoveFirst removes and returns the head of the list
+func (ll *LinkedList[T]) RemoveFirst() (T, error) {
 	if ll.head == nil {
-		ll.head = newNode
-	} else {
-		current := ll.head
-		for current.Next != nil {
-			current = current.Next
-		}
-		current.Next = newNode
+		var zeroValue T
+		return zeroValue, errListEmpty
 	}
-	ll.size++
+
+	value := ll.head.Item
+	ll.unlink(ll.head)
+	return value, nil
+}
+
+// RemoveLast removes and returns the tail of the list
+func (ll *LinkedList[T]) RemoveLast() (T, error) {
+	if ll.tail == nil {
+		var zeroValue T
+		return zeroValue, errListEmpty
+	}
+
+	value := ll.tail.Item
+	ll.unlink(ll.tail)
+	return value, nil
+}
+
+// PeekFirst returns the head of the list without removing it
+func (ll *LinkedList[T]) PeekFirst() (T, error) {
+	if ll.head == nil {
+		var zeroValue T
+		return zeroValue, errListEmpty
+	}
+	return ll.head.Item, nil
+}
+
+// PeekLast returns the tail of the list without removing it
+func (ll *LinkedList[T]) PeekLast() (T, error) {
+	if ll.tail == nil {
+		var zeroValue T
+		return zeroValue, errListEmpty
+	}
+	return ll.tail.Item, nil
+}
+
+// Offer appends an item to the tail of the list, it never fails as the list is unbounded
+func (ll *LinkedList[T]) Offer(value T) error {
+	ll.AddLast(value)
+	return nil
+}
+
+// Poll removes and returns the head of the list
+func (ll *LinkedList[T]) Poll() (T, error) {
+	return ll.RemoveFirst()
+}
+
+// Peek returns the head of the list without removing it
+func (ll *LinkedList[T]) Peek() (T, error) {
+	return ll.PeekFirst()
+}
+
+// Dump returns a slice containing all elements, from head to tail
+func (ll *LinkedList[T]) Dump() []T {
+	return ll.ToSlice()
 }
 
 // Remove removes an element at the specified index
@@ -48,16 +124,7 @@ func (ll *LinkedList[T]) Remove(index int) error {
 		return err
 	}
 
-	if index == 0 {
-		ll.head = ll.head.Next
-	} else {
-		prev := ll.head
-		for i := 0; i < index-1; i++ {
-			prev = prev.Next
-		}
-		prev.Next = prev.Next.Next
-	}
-	ll.size--
+	ll.unlink(ll.nodeAt(index))
 	return nil
 }
 
@@ -68,14 +135,347 @@ func (ll *LinkedList[T]) Get(index int) (T, error) {
 		return zeroValue, err
 	}
 
-	current := ll.head
-	for i := 0; i < index; i++ {
-		current = current.Next
-	}
-	return current.Item, nil
+	return ll.nodeAt(index).Item, nil
 }
 
 // Size returns the number of elements in the list
 func (ll *LinkedList[T]) Size() int {
 	return ll.size
 }
+
+// IsEmpty reports whether the list has no elements
+func (ll *LinkedList[T]) IsEmpty() bool {
+	return ll.size == 0
+}
+
+// AddAll appends every given item, in order, to the tail of the list
+func (ll *LinkedList[T]) AddAll(values ...T) {
+	for _, value := range values {
+		ll.AddLast(value)
+	}
+}
+
+// Insert adds an item at the specified index, shifting the following elements towards the tail
+func (ll *LinkedList[T]) Insert(index int, value T) error {
+	if err := ll.validateInsertIndex(index, ll.Size()); err != nil {
+		return err
+	}
+
+	if index == ll.size {
+		ll.AddLast(value)
+	} else {
+		ll.linkBefore(value, ll.nodeAt(index))
+	}
+	return nil
+}
+
+// Set replaces the element at the specified index
+func (ll *LinkedList[T]) Set(index int, value T) error {
+	if err := ll.validateIndex(index, ll.Size()); err != nil {
+		return err
+	}
+
+	ll.nodeAt(index).Item = value
+	return nil
+}
+
+// RemoveRange removes the elements between from, inclusive, and to, exclusive
+func (ll *LinkedList[T]) RemoveRange(from, to int) error {
+	if err := ll.validateRange(from, to, ll.Size()); err != nil {
+		return err
+	}
+	if from == to {
+		return nil
+	}
+
+	// Splice out the whole range at once by linking its neighbours together
+	first := ll.nodeAt(from)
+	last := first
+	for i := from + 1; i < to; i++ {
+		last = last.Next
+	}
+	if first.Prev == nil {
+		ll.head = last.Next
+	} else {
+		first.Prev.Next = last.Next
+	}
+	if last.Next == nil {
+		ll.tail = first.Prev
+	} else {
+		last.Next.Prev = first.Prev
+	}
+	ll.size -= to - from
+	ll.modCount++
+	return nil
+}
+
+// ReplaceRange replaces the elements between from, inclusive, and to, exclusive, with the given values,
+// which do not need to be as many as the elements they replace
+func (ll *LinkedList[T]) ReplaceRange(from, to int, values ...T) error {
+	if err := ll.validateRange(from, to, ll.Size()); err != nil {
+		return err
+	}
+
+	var current *collections.DoublyNode[T]
+	if from < ll.size {
+		current = ll.nodeAt(from)
+	}
+
+	// Overwrite the nodes both ranges have in common, then unlink or link whatever is left over
+	replaced := 0
+	for ; replaced < len(values) && from+replaced < to; replaced++ {
+		current.Item = values[replaced]
+		current = current.Next
+	}
+	for i := from + replaced; i < to; i++ {
+		next := current.Next
+		ll.unlink(current)
+		current = next
+	}
+	for _, value := range values[replaced:] {
+		ll.linkBefore(value, current)
+	}
+	return nil
+}
+
+// RemoveIf removes every element matching the predicate and returns how many were removed
+func (ll *LinkedList[T]) RemoveIf(predicate func(T) bool) int {
+	removed := 0
+	for current := ll.head; current != nil; {
+		next := current.Next
+		if predicate(current.Item) {
+			ll.unlink(current)
+			removed++
+		}
+		current = next
+	}
+	return removed
+}
+
+// RetainAll removes every element not equal, according to eq, to any of values and returns how many were removed
+func (ll *LinkedList[T]) RetainAll(values []T, eq func(a, b T) bool) int {
+	return ll.RemoveIf(func(value T) bool {
+		return ll.indexOf(values, value, eq) < 0
+	})
+}
+
+// IndexOf returns the index of the first element equal to value according to eq, or -1 if there is none
+func (ll *LinkedList[T]) IndexOf(value T, eq func(a, b T) bool) int {
+	index := 0
+	for current := ll.head; current != nil; current = current.Next {
+		if eq(current.Item, value) {
+			return index
+		}
+		index++
+	}
+	return -1
+}
+
+// LastIndexOf returns the index of the last element equal to value according to eq, or -1 if there is none
+func (ll *LinkedList[T]) LastIndexOf(value T, eq func(a, b T) bool) int {
+	index := ll.size - 1
+	for current := ll.tail; current != nil; current = current.Prev {
+		if eq(current.Item, value) {
+			return index
+		}
+		index--
+	}
+	return -1
+}
+
+// Contains reports whether the list holds an element equal to value according to eq
+func (ll *LinkedList[T]) Contains(value T, eq func(a, b T) bool) bool {
+	return ll.IndexOf(value, eq) >= 0
+}
+
+// Clear removes every element from the list
+func (ll *LinkedList[T]) Clear() {
+	ll.head = nil
+	ll.tail = nil
+	ll.size = 0
+	ll.modCount++
+}
+
+// ToSlice returns a copy of the elements of the list
+func (ll *LinkedList[T]) ToSlice() []T {
+	result := make([]T, 0, ll.size)
+	for current := ll.head; current != nil; current = current.Next {
+		result = append(result, current.Item)
+	}
+	return result
+}
+
+// Reverse reverses the order of the elements in place by relinking the nodes
+func (ll *LinkedList[T]) Reverse() {
+	for current := ll.head; current != nil; current = current.Prev {
+		current.Next, current.Prev = current.Prev, current.Next
+	}
+	ll.head, ll.tail = ll.tail, ll.head
+	ll.modCount++
+}
+
+// Swap exchanges the elements at the specified indices
+func (ll *LinkedList[T]) Swap(i, j int) error {
+	if err := ll.validateIndex(i, ll.Size()); err != nil {
+		return err
+	}
+	if err := ll.validateIndex(j, ll.Size()); err != nil {
+		return err
+	}
+
+	first, second := ll.nodeAt(i), ll.nodeAt(j)
+	first.Item, second.Item = second.Item, first.Item
+	return nil
+}
+
+// Sort stably sorts the elements according to cmp by merge sorting the nodes, without allocating
+func (ll *LinkedList[T]) Sort(cmp func(a, b T) int) {
+	if ll.size < 2 {
+		return
+	}
+
+	ll.head = mergeSortNodes(ll.head, ll.size, cmp)
+
+	// The merges only maintain the Next links, restore the Prev ones and the tail
+	var prev *collections.DoublyNode[T]
+	for current := ll.head; current != nil; current = current.Next {
+		current.Prev = prev
+		prev = current
+	}
+	ll.tail = prev
+	ll.modCount++
+}
+
+// IsSorted reports whether the elements are in the order defined by cmp
+func (ll *LinkedList[T]) IsSorted(cmp func(a, b T) int) bool {
+	for current := ll.head; current != nil && current.Next != nil; current = current.Next {
+		if cmp(current.Next.Item, current.Item) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// BinarySearch looks for value in a list sorted according to cmp, returning the position where it was found,
+// or where it would be inserted to keep the list sorted, and whether it was found.
+// Nodes cannot be reached at random, so this walks the list in linear time.
+func (ll *LinkedList[T]) BinarySearch(value T, cmp func(a, b T) int) (int, bool) {
+	index := 0
+	for current := ll.head; current != nil; current = current.Next {
+		if order := cmp(current.Item, value); order >= 0 {
+			return index, order == 0
+		}
+		index++
+	}
+	return index, false
+}
+
+// All returns an iter.Seq over the elements of the list, from head to tail
+func (ll *LinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := ll.head; current != nil; current = current.Next {
+			if !yield(current.Item) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iter.Seq over the elements of the list, from tail to head
+func (ll *LinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := ll.tail; current != nil; current = current.Prev {
+			if !yield(current.Item) {
+				return
+			}
+		}
+	}
+}
+
+// mergeSortNodes sorts the chain of length nodes starting at head by their Next links and returns its new head
+func mergeSortNodes[T any](head *collections.DoublyNode[T], length int, cmp func(a, b T) int) *collections.DoublyNode[T] {
+	if length < 2 {
+		head.Next = nil
+		return head
+	}
+
+	half := length / 2
+	second := head
+	for i := 0; i < half; i++ {
+		second = second.Next
+	}
+
+	first := mergeSortNodes(head, half, cmp)
+	second = mergeSortNodes(second, length-half, cmp)
+
+	var merged collections.DoublyNode[T]
+	tail := &merged
+	for first != nil && second != nil {
+		// Taking from the first chain on ties keeps the sort stable
+		if cmp(second.Item, first.Item) < 0 {
+			tail.Next, second = second, second.Next
+		} else {
+			tail.Next, first = first, first.Next
+		}
+		tail = tail.Next
+	}
+	if first != nil {
+		tail.Next = first
+	} else {
+		tail.Next = second
+	}
+	return merged.Next
+}
+
+// nodeAt returns the node at index, which must be valid, walking from whichever end is closer
+func (ll *LinkedList[T]) nodeAt(index int) *collections.DoublyNode[T] {
+	if index < ll.size/2 {
+		current := ll.head
+		for i := 0; i < index; i++ {
+			current = current.Next
+		}
+		return current
+	}
+
+	current := ll.tail
+	for i := ll.size - 1; i > index; i-- {
+		current = current.Prev
+	}
+	return current
+}
+
+// linkBefore inserts a new node holding value right before successor, or at the tail if successor is nil
+func (ll *LinkedList[T]) linkBefore(value T, successor *collections.DoublyNode[T]) {
+	newNode := &collections.DoublyNode[T]{Item: value, Next: successor}
+	if successor == nil {
+		newNode.Prev = ll.tail
+		ll.tail = newNode
+	} else {
+		newNode.Prev = successor.Prev
+		successor.Prev = newNode
+	}
+
+	if newNode.Prev == nil {
+		ll.head = newNode
+	} else {
+		newNode.Prev.Next = newNode
+	}
+	ll.size++
+	ll.modCount++
+}
+
+// unlink removes node from the list in constant time
+func (ll *LinkedList[T]) unlink(node *collections.DoublyNode[T]) {
+	if node.Prev == nil {
+		ll.head = node.Next
+	} else {
+		node.Prev.Next = node.Next
+	}
+	if node.Next == nil {
+		ll.tail = node.Prev
+	} else {
+		node.Next.Prev = node.Prev
+	}
+	ll.size--
+	ll.modCount++
+}
@@ -1,39 +1,83 @@
 package lists
 
 import (
-	"errors"
 	"github.com/jorge-barroso/collections"
 )
 
 // LinkedListIterator struct for LinkedList
 type LinkedListIterator[T any] struct {
-	current *collections.Node[T]
-	started bool
+	list             *LinkedList[T]
+	current          *collections.DoublyNode[T] // Node the iterator is positioned on
+	next             *collections.DoublyNode[T] // Node the next call to Next moves to
+	expectedModCount int                        // modCount of the list when the iterator last synchronised with it
+	failed           bool                       // Set once a concurrent modification has been reported by Next
 }
 
+// Ensure LinkedListIterator implements ListIterator
+var _ ListIterator[int] = (*LinkedListIterator[int])(nil)
+
+// Next advances the iterator to the next node
 func (iter *LinkedListIterator[T]) Next() bool {
-	if !iter.started {
-		iter.started = true
-		return iter.current != nil
+	if iter.modified() {
+		if iter.failed {
+			return false
+		}
+		iter.failed = true
+		return true
 	}
 
-	if iter.current != nil && iter.current.Next != nil {
-		iter.current = iter.current.Next
-		return true
+	if iter.next == nil {
+		return false
 	}
-	return false
+
+	iter.current = iter.next
+	iter.next = iter.current.Next
+	return true
 }
 
 // Value retrieves the value of the current node.
 func (iter *LinkedListIterator[T]) Value() (T, error) {
-	if !iter.started {
-		var zeroValue T
-		return zeroValue, errors.New("iterator is not positioned on a valid element")
+	var zeroValue T
+	if err := iter.checkPositioned(); err != nil {
+		return zeroValue, err
+	}
+	return iter.current.Item, nil
+}
+
+// Remove unlinks the current node from the list in constant time
+func (iter *LinkedListIterator[T]) Remove() error {
+	if err := iter.checkPositioned(); err != nil {
+		return err
+	}
+
+	iter.list.unlink(iter.current)
+	iter.current = nil
+	iter.expectedModCount = iter.list.modCount
+	return nil
+}
+
+// Set replaces the value of the current node
+func (iter *LinkedListIterator[T]) Set(value T) error {
+	if err := iter.checkPositioned(); err != nil {
+		return err
 	}
 
+	iter.current.Item = value
+	return nil
+}
+
+// checkPositioned returns an error unless the iterator sits on a valid, unmodified node
+func (iter *LinkedListIterator[T]) checkPositioned() error {
+	if iter.modified() {
+		return collections.ErrConcurrentModification
+	}
 	if iter.current == nil {
-		var zeroValue T
-		return zeroValue, errors.New("no more elements")
+		return collections.ErrNoSuchElement
 	}
-	return iter.current.Item, nil
+	return nil
+}
+
+// modified reports whether the list has been structurally modified behind the iterator
+func (iter *LinkedListIterator[T]) modified() bool {
+	return iter.list.modCount != iter.expectedModCount
 }
@@ -1,8 +1,11 @@
 package lists
 
 import (
+	"slices"
 	"testing"
 
+	"github.com/jorge-barroso/collections"
+	"github.com/jorge-barroso/collections/collectionstest"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -162,3 +165,152 @@ func TestLinkedListIterator_MultipleIterations(t *testing.T) {
 	assert.Nil(t, err, "Value() should not return error after iteration ends, but remain in the last value")
 	assert.Equal(t, 2, val, "Value mismatch for the second iterator position, Next() should not have moved past the last position")
 }
+
+func TestLinkedList_All(t *testing.T) {
+	list := NewLinkedList[int]()
+	assert.Empty(t, slices.Collect(list.All()), "Expected no elements for an empty list")
+
+	list.Add(1)
+	list.Add(2)
+	list.Add(3)
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(list.All()), "Elements mismatch when ranging over the list")
+}
+
+func TestLinkedList_IteratorConformance(t *testing.T) {
+	list := NewLinkedList[int]()
+	collectionstest.TestIterable[int](t, list, nil)
+
+	for _, v := range []int{1, 2, 3} {
+		list.Add(v)
+	}
+	collectionstest.TestIterable[int](t, list, []int{1, 2, 3})
+}
+
+func TestLinkedListIterator_FailFast(t *testing.T) {
+	list := NewLinkedList[int]()
+	for _, v := range []int{1, 2, 3} {
+		list.Add(v)
+	}
+	collectionstest.TestFailFastIterable[int](t, list, func() { list.Add(4) })
+
+	iter := list.NewIterator()
+	iter.Next()
+	assert.NoError(t, list.Remove(1), "Unexpected error when removing element at index 1")
+	_, err := iter.Value()
+	assert.ErrorIs(t, err, collections.ErrConcurrentModification, "Expected the removal to be detected")
+}
+
+func TestLinkedListIterator_RemoveAndSet(t *testing.T) {
+	list := NewLinkedList[int]()
+	for i := 1; i <= 6; i++ {
+		list.Add(i)
+	}
+
+	iter := list.NewListIterator()
+	assert.ErrorIs(t, iter.Remove(), collections.ErrNoSuchElement, "Remove() before Next() should fail")
+
+	for iter.Next() {
+		value, err := iter.Value()
+		assert.NoError(t, err, "Unexpected error during iteration")
+		if value <= 2 || value == 6 {
+			// Covers removing the head, consecutive nodes and the last node
+			assert.NoError(t, iter.Remove(), "Unexpected error when removing through the iterator")
+			assert.ErrorIs(t, iter.Set(0), collections.ErrNoSuchElement, "Set() should fail after Remove()")
+		} else {
+			assert.NoError(t, iter.Set(value*10), "Unexpected error when setting through the iterator")
+		}
+	}
+
+	assert.Equal(t, []int{30, 40, 50}, slices.Collect(list.All()), "Elements mismatch after filtering through the iterator")
+	assert.Equal(t, 3, list.Size(), "List size mismatch after removing through the iterator")
+
+	list.Add(60)
+	assert.Equal(t, []int{30, 40, 50, 60}, slices.Collect(list.All()), "Appending should still work after removing the last node")
+}
+
+func TestLinkedList_DequeOperations(t *testing.T) {
+	list := NewLinkedList[int]()
+
+	_, err := list.PeekFirst()
+	assert.Error(t, err, "Expected error when peeking the head of an empty list")
+	_, err = list.PeekLast()
+	assert.Error(t, err, "Expected error when peeking the tail of an empty list")
+	_, err = list.RemoveFirst()
+	assert.Error(t, err, "Expected error when removing the head of an empty list")
+	_, err = list.RemoveLast()
+	assert.Error(t, err, "Expected error when removing the tail of an empty list")
+
+	list.AddLast(2)
+	list.AddFirst(1)
+	list.AddLast(3)
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(list.All()), "Elements mismatch after adding at both ends")
+	assert.Equal(t, []int{3, 2, 1}, slices.Collect(list.Backward()), "Elements mismatch when ranging backwards")
+
+	first, err := list.PeekFirst()
+	assert.NoError(t, err, "Unexpected error when peeking the head")
+	assert.Equal(t, 1, first, "Head mismatch")
+	last, err := list.PeekLast()
+	assert.NoError(t, err, "Unexpected error when peeking the tail")
+	assert.Equal(t, 3, last, "Tail mismatch")
+
+	first, err = list.RemoveFirst()
+	assert.NoError(t, err, "Unexpected error when removing the head")
+	assert.Equal(t, 1, first, "Removed head mismatch")
+	last, err = list.RemoveLast()
+	assert.NoError(t, err, "Unexpected error when removing the tail")
+	assert.Equal(t, 3, last, "Removed tail mismatch")
+	assert.Equal(t, 1, list.Size(), "List size mismatch after removing both ends")
+
+	value, err := list.RemoveLast()
+	assert.NoError(t, err, "Unexpected error when removing the only element")
+	assert.Equal(t, 2, value, "Removed element mismatch")
+	assert.True(t, list.IsEmpty(), "List should be empty after removing every element")
+
+	list.AddFirst(4)
+	assert.Equal(t, []int{4}, slices.Collect(list.Backward()), "Tail should be restored after emptying the list")
+}
+
+func TestLinkedList_QueueOperations(t *testing.T) {
+	list := NewLinkedList[int]()
+	for i := 1; i <= 3; i++ {
+		assert.NoError(t, list.Offer(i), "Unexpected error when offering %d", i)
+	}
+
+	head, err := list.Peek()
+	assert.NoError(t, err, "Unexpected error when peeking")
+	assert.Equal(t, 1, head, "Peek should return the head")
+	assert.Equal(t, []int{1, 2, 3}, list.Dump(), "Dump should return every element in order")
+	assert.Equal(t, 3, list.Size(), "Dump should not remove elements")
+
+	for i := 1; i <= 3; i++ {
+		value, err := list.Poll()
+		assert.NoError(t, err, "Unexpected error when polling")
+		assert.Equal(t, i, value, "Poll should follow FIFO order")
+	}
+	_, err = list.Poll()
+	assert.Error(t, err, "Expected error when polling an empty list")
+}
+
+func TestLinkedList_IndexFromEitherEnd(t *testing.T) {
+	list := NewLinkedList[int]()
+	for i := 0; i < 10; i++ {
+		list.Add(i)
+	}
+
+	for i := 0; i < 10; i++ {
+		value, err := list.Get(i)
+		assert.NoError(t, err, "Unexpected error when getting value at index %d", i)
+		assert.Equal(t, i, value, "Value mismatch at index %d", i)
+	}
+
+	assert.NoError(t, list.Remove(8), "Unexpected error when removing near the tail")
+	assert.NoError(t, list.Remove(1), "Unexpected error when removing near the head")
+	assert.NoError(t, list.Insert(7, 80), "Unexpected error when inserting near the tail")
+	assert.Equal(t, []int{0, 2, 3, 4, 5, 6, 7, 80, 9}, slices.Collect(list.All()), "Elements mismatch after editing from both ends")
+	assert.Equal(t, []int{9, 80, 7, 6, 5, 4, 3, 2, 0}, slices.Collect(list.Backward()), "Prev links mismatch after editing from both ends")
+
+	assert.NoError(t, list.RemoveRange(6, 9), "Unexpected error when removing the trailing range")
+	list.Reverse()
+	assert.Equal(t, []int{6, 5, 4, 3, 2, 0}, slices.Collect(list.All()), "Elements mismatch after reversing")
+	assert.Equal(t, []int{0, 2, 3, 4, 5, 6}, slices.Collect(list.Backward()), "Prev links mismatch after reversing")
+}
@@ -1,9 +1,46 @@
 package lists
 
-// List interface defining common list operations
+import "github.com/jorge-barroso/collections"
+
+// List interface defining common list operations.
+// Positions are zero-based, ranges are half-open [from, to), and methods that need to
+// compare elements take an equality function so that T is not required to be comparable.
+// Views such as SubList fail fast once their backing list is modified other than through them,
+// returning collections.ErrConcurrentModification or, from methods without an error result, panicking with it.
 type List[T any] interface {
-	Add(T)
-	Remove(T) error
-	Get(int) (T, error)
-	Size() int
+	collections.Iterable[T]
+	Add(T)                                                  // Appends an element to the end of the list
+	AddAll(...T)                                            // Appends every element, in order, to the end of the list
+	Insert(index int, value T) error                        // Inserts an element at index, shifting the following ones
+	Set(index int, value T) error                           // Replaces the element at index
+	Get(index int) (T, error)                               // Retrieves the element at index
+	Remove(index int) error                                 // Removes the element at index
+	RemoveRange(from, to int) error                         // Removes the elements in [from, to)
+	ReplaceRange(from, to int, values ...T) error           // Replaces the elements in [from, to) with values
+	RemoveIf(predicate func(T) bool) int                    // Removes the elements matching predicate, returning how many
+	RetainAll(values []T, eq func(a, b T) bool) int         // Keeps only the elements found in values, returning how many were removed
+	IndexOf(value T, eq func(a, b T) bool) int              // Returns the first index of value, or -1
+	LastIndexOf(value T, eq func(a, b T) bool) int          // Returns the last index of value, or -1
+	Contains(value T, eq func(a, b T) bool) bool            // Reports whether value is in the list
+	Clear()                                                 // Removes every element
+	ToSlice() []T                                           // Returns a copy of the elements as a slice
+	Reverse()                                               // Reverses the order of the elements in place
+	Swap(i, j int) error                                    // Exchanges the elements at i and j
+	Sort(cmp func(a, b T) int)                              // Stably sorts the elements according to cmp
+	IsSorted(cmp func(a, b T) int) bool                     // Reports whether the elements are sorted according to cmp
+	BinarySearch(value T, cmp func(a, b T) int) (int, bool) // Finds value, or its insertion point, in a sorted list
+	Size() int                                              // Returns the number of elements
+	IsEmpty() bool                                          // Reports whether the list has no elements
+}
+
+// Capacitor is implemented by lists backed by a growable array, letting callers control its capacity
+type Capacitor interface {
+	EnsureCapacity(capacity int) // Makes room for at least capacity elements without reallocating
+	TrimToSize()                 // Releases any room held beyond the current elements
+}
+
+// ListIterator is a MutableIterator over a list that can also replace the element it is positioned on
+type ListIterator[T any] interface {
+	collections.MutableIterator[T]
+	Set(T) error
 }
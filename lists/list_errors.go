@@ -0,0 +1,8 @@
+package lists
+
+import "errors"
+
+var (
+	// errListEmpty is returned when attempting to retrieve an element from either end of an empty list
+	errListEmpty = errors.New("list is empty")
+)
@@ -11,3 +11,39 @@ func (lo *listOps[T]) validateIndex(index, size int) error {
 	}
 	return nil
 }
+
+// validateInsertIndex accepts every position an element can be inserted at, including size itself
+func (lo *listOps[T]) validateInsertIndex(index, size int) error {
+	if index < 0 || index > size {
+		return fmt.Errorf("index out of bounds, must be between 0 and %d, but %d was provided", size, index)
+	}
+	return nil
+}
+
+// validateRange checks that [from, to) is a valid range of a list of the given size
+func (lo *listOps[T]) validateRange(from, to, size int) error {
+	if from < 0 || to > size || from > to {
+		return fmt.Errorf("range out of bounds, must be within 0 and %d, but [%d, %d) was provided", size, from, to)
+	}
+	return nil
+}
+
+// indexOf returns the position of the first element of values equal to value, or -1
+func (lo *listOps[T]) indexOf(values []T, value T, eq func(a, b T) bool) int {
+	for i, candidate := range values {
+		if eq(candidate, value) {
+			return i
+		}
+	}
+	return -1
+}
+
+// lastIndexOf returns the position of the last element of values equal to value, or -1
+func (lo *listOps[T]) lastIndexOf(values []T, value T, eq func(a, b T) bool) int {
+	for i := len(values) - 1; i >= 0; i-- {
+		if eq(values[i], value) {
+			return i
+		}
+	}
+	return -1
+}
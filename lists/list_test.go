@@ -0,0 +1,256 @@
+package lists
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/jorge-barroso/collections"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// listImplementations lists every List implementation so behaviour shared through the
+// interface can be checked once for all of them
+var listImplementations = map[string]func() List[int]{
+	"ArrayList":       func() List[int] { return NewArrayList[int]() },
+	"LinkedList":      func() List[int] { return NewLinkedList[int]() },
+	"CopyOnWriteList": func() List[int] { return NewCopyOnWriteList[int]() },
+	"SubList":         newPaddedSubList,
+}
+
+// newPaddedSubList returns an empty view over the middle of an ArrayList, so writes through
+// the view that leak past its bounds would show up as wrong elements
+func newPaddedSubList() List[int] {
+	list := NewArrayList[int]()
+	list.AddAll(-1, -2)
+	view, _ := list.SubList(1, 1)
+	return view
+}
+
+func intEquals(a, b int) bool { return a == b }
+
+// forEachList runs the test against every List implementation, starting with the given elements
+func forEachList(t *testing.T, initial []int, test func(t *testing.T, list List[int])) {
+	for name, newList := range listImplementations {
+		t.Run(name, func(t *testing.T) {
+			list := newList()
+			list.AddAll(initial...)
+			test(t, list)
+		})
+	}
+}
+
+func TestList_AddAll(t *testing.T) {
+	forEachList(t, nil, func(t *testing.T, list List[int]) {
+		assert.True(t, list.IsEmpty(), "Expected an empty list")
+		list.AddAll()
+		assert.True(t, list.IsEmpty(), "AddAll() without values should not add anything")
+
+		list.AddAll(1, 2)
+		list.AddAll(3, 4)
+		assert.False(t, list.IsEmpty(), "Expected a non-empty list after AddAll()")
+		assert.Equal(t, []int{1, 2, 3, 4}, list.ToSlice(), "Elements mismatch after AddAll()")
+	})
+}
+
+func TestList_Insert(t *testing.T) {
+	forEachList(t, []int{2, 4}, func(t *testing.T, list List[int]) {
+		assert.NoError(t, list.Insert(0, 1), "Unexpected error when inserting at the head")
+		assert.NoError(t, list.Insert(2, 3), "Unexpected error when inserting in the middle")
+		assert.NoError(t, list.Insert(4, 5), "Unexpected error when inserting at the end")
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, list.ToSlice(), "Elements mismatch after Insert()")
+
+		assert.Error(t, list.Insert(-1, 0), "Expected error for a negative index")
+		assert.Error(t, list.Insert(6, 0), "Expected error for an index past the end")
+		assert.Equal(t, 5, list.Size(), "Failed inserts should not change the size")
+	})
+}
+
+func TestList_Set(t *testing.T) {
+	forEachList(t, []int{1, 2, 3}, func(t *testing.T, list List[int]) {
+		assert.NoError(t, list.Set(1, 20), "Unexpected error when setting index 1")
+		assert.Equal(t, []int{1, 20, 3}, list.ToSlice(), "Elements mismatch after Set()")
+		assert.Error(t, list.Set(3, 0), "Expected error when setting past the end")
+	})
+}
+
+func TestList_RemoveRange(t *testing.T) {
+	forEachList(t, []int{0, 1, 2, 3, 4, 5}, func(t *testing.T, list List[int]) {
+		assert.NoError(t, list.RemoveRange(1, 3), "Unexpected error when removing [1, 3)")
+		assert.Equal(t, []int{0, 3, 4, 5}, list.ToSlice(), "Elements mismatch after removing a middle range")
+
+		assert.NoError(t, list.RemoveRange(2, 2), "An empty range should be accepted")
+		assert.Equal(t, 4, list.Size(), "An empty range should not remove anything")
+
+		assert.NoError(t, list.RemoveRange(0, 1), "Unexpected error when removing the head")
+		assert.NoError(t, list.RemoveRange(1, 3), "Unexpected error when removing the tail")
+		assert.Equal(t, []int{3}, list.ToSlice(), "Elements mismatch after removing the head and tail")
+
+		assert.Error(t, list.RemoveRange(-1, 1), "Expected error for a negative start")
+		assert.Error(t, list.RemoveRange(0, 2), "Expected error for an end past the size")
+		assert.Error(t, list.RemoveRange(1, 0), "Expected error for a start after the end")
+
+		list.AddAll(4, 5)
+		assert.Equal(t, []int{3, 4, 5}, list.ToSlice(), "Appending should still work after removing the tail")
+	})
+}
+
+func TestList_Search(t *testing.T) {
+	forEachList(t, []int{1, 2, 3, 2, 1}, func(t *testing.T, list List[int]) {
+		assert.Equal(t, 1, list.IndexOf(2, intEquals), "IndexOf mismatch")
+		assert.Equal(t, 3, list.LastIndexOf(2, intEquals), "LastIndexOf mismatch")
+		assert.Equal(t, -1, list.IndexOf(7, intEquals), "IndexOf should return -1 for a missing value")
+		assert.Equal(t, -1, list.LastIndexOf(7, intEquals), "LastIndexOf should return -1 for a missing value")
+		assert.True(t, list.Contains(3, intEquals), "Expected the list to contain 3")
+		assert.False(t, list.Contains(7, intEquals), "Expected the list not to contain 7")
+
+		// Custom equality, e.g. comparing by parity
+		sameParity := func(a, b int) bool { return a%2 == b%2 }
+		assert.Equal(t, 1, list.IndexOf(4, sameParity), "IndexOf should use the equality function")
+	})
+}
+
+func TestList_ClearAndToSlice(t *testing.T) {
+	forEachList(t, []int{1, 2, 3}, func(t *testing.T, list List[int]) {
+		slice := list.ToSlice()
+		slice[0] = 100
+		value, _ := list.Get(0)
+		assert.Equal(t, 1, value, "ToSlice() should return a copy")
+
+		list.Clear()
+		assert.True(t, list.IsEmpty(), "Expected an empty list after Clear()")
+		assert.Equal(t, []int{}, list.ToSlice(), "ToSlice() should return an empty slice after Clear()")
+
+		list.Add(4)
+		assert.Equal(t, []int{4}, list.ToSlice(), "The list should be usable after Clear()")
+	})
+}
+
+func TestList_ReverseAndSwap(t *testing.T) {
+	forEachList(t, []int{1, 2, 3, 4}, func(t *testing.T, list List[int]) {
+		list.Reverse()
+		assert.Equal(t, []int{4, 3, 2, 1}, list.ToSlice(), "Elements mismatch after Reverse()")
+
+		assert.NoError(t, list.Swap(0, 3), "Unexpected error when swapping 0 and 3")
+		assert.NoError(t, list.Swap(1, 1), "Swapping an index with itself should be accepted")
+		assert.Equal(t, []int{1, 3, 2, 4}, list.ToSlice(), "Elements mismatch after Swap()")
+		assert.Error(t, list.Swap(0, 4), "Expected error when swapping past the end")
+
+		list.Add(5)
+		assert.Equal(t, []int{1, 3, 2, 4, 5}, list.ToSlice(), "Appending should still work after Reverse()")
+	})
+
+	forEachList(t, nil, func(t *testing.T, list List[int]) {
+		list.Reverse()
+		assert.True(t, list.IsEmpty(), "Reversing an empty list should keep it empty")
+	})
+}
+
+func TestList_ReplaceRange(t *testing.T) {
+	forEachList(t, []int{1, 2, 3, 4, 5}, func(t *testing.T, list List[int]) {
+		assert.NoError(t, list.ReplaceRange(1, 3, 20, 30), "Unexpected error when replacing with as many elements")
+		assert.Equal(t, []int{1, 20, 30, 4, 5}, list.ToSlice(), "Elements mismatch after a same-size replacement")
+
+		assert.NoError(t, list.ReplaceRange(1, 4, 9), "Unexpected error when replacing with fewer elements")
+		assert.Equal(t, []int{1, 9, 5}, list.ToSlice(), "Elements mismatch after a shrinking replacement")
+
+		assert.NoError(t, list.ReplaceRange(2, 2, 6, 7), "Unexpected error when replacing an empty range")
+		assert.Equal(t, []int{1, 9, 6, 7, 5}, list.ToSlice(), "Elements mismatch after replacing an empty range")
+
+		assert.NoError(t, list.ReplaceRange(4, 5, 50, 60, 70), "Unexpected error when replacing with more elements")
+		assert.NoError(t, list.ReplaceRange(7, 7, 80), "Unexpected error when replacing at the end")
+		assert.Equal(t, []int{1, 9, 6, 7, 50, 60, 70, 80}, list.ToSlice(), "Elements mismatch after a growing replacement")
+
+		assert.NoError(t, list.ReplaceRange(0, 8), "Unexpected error when replacing everything with nothing")
+		assert.True(t, list.IsEmpty(), "Expected an empty list after replacing everything with nothing")
+
+		assert.Error(t, list.ReplaceRange(0, 1, 1), "Expected error for a range past the end")
+		assert.Error(t, list.ReplaceRange(-1, 0, 1), "Expected error for a negative range")
+	})
+}
+
+func TestList_RemoveIfAndRetainAll(t *testing.T) {
+	forEachList(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, func(t *testing.T, list List[int]) {
+		removed := list.RemoveIf(func(v int) bool { return v%2 == 0 })
+		assert.Equal(t, 4, removed, "RemoveIf() should report every removed element")
+		assert.Equal(t, []int{1, 3, 5, 7}, list.ToSlice(), "Elements mismatch after RemoveIf()")
+
+		assert.Zero(t, list.RemoveIf(func(v int) bool { return v > 10 }), "RemoveIf() without matches should not remove anything")
+
+		removed = list.RetainAll([]int{7, 3, 42}, intEquals)
+		assert.Equal(t, 2, removed, "RetainAll() should report every removed element")
+		assert.Equal(t, []int{3, 7}, list.ToSlice(), "Elements mismatch after RetainAll()")
+
+		removed = list.RetainAll(nil, intEquals)
+		assert.Equal(t, 2, removed, "RetainAll() without values should remove every element")
+		assert.True(t, list.IsEmpty(), "Expected an empty list after RetainAll() without values")
+	})
+}
+
+func TestList_Sort(t *testing.T) {
+	forEachList(t, []int{5, 3, 8, 1, 9, 2, 7}, func(t *testing.T, list List[int]) {
+		assert.False(t, list.IsSorted(cmp.Compare[int]), "Expected the initial elements not to be sorted")
+
+		list.Sort(cmp.Compare[int])
+		assert.Equal(t, []int{1, 2, 3, 5, 7, 8, 9}, list.ToSlice(), "Elements mismatch after Sort()")
+		assert.True(t, list.IsSorted(cmp.Compare[int]), "Expected the elements to be sorted after Sort()")
+
+		index, found := list.BinarySearch(7, cmp.Compare[int])
+		assert.True(t, found, "BinarySearch() should find an existing element")
+		assert.Equal(t, 4, index, "BinarySearch() index mismatch for an existing element")
+
+		index, found = list.BinarySearch(4, cmp.Compare[int])
+		assert.False(t, found, "BinarySearch() should not find a missing element")
+		assert.Equal(t, 3, index, "BinarySearch() should return the insertion point of a missing element")
+
+		index, found = list.BinarySearch(10, cmp.Compare[int])
+		assert.False(t, found, "BinarySearch() should not find an element past the end")
+		assert.Equal(t, 7, index, "BinarySearch() should return the size for an element past the end")
+
+		list.Sort(collections.ReverseOrder[int]())
+		assert.Equal(t, []int{9, 8, 7, 5, 3, 2, 1}, list.ToSlice(), "Elements mismatch after sorting in reverse order")
+	})
+}
+
+func TestList_ReorderingFailsIterators(t *testing.T) {
+	reorderings := map[string]func(list List[int]){
+		"Sort":    func(list List[int]) { list.Sort(collections.ReverseOrder[int]()) },
+		"Reverse": func(list List[int]) { list.Reverse() },
+	}
+
+	forEachList(t, []int{1, 2, 3}, func(t *testing.T, list List[int]) {
+		if _, ok := list.(*CopyOnWriteList[int]); ok {
+			t.Skip("CopyOnWriteList iterators traverse a snapshot and never fail")
+		}
+		for name, reorder := range reorderings {
+			it := list.NewIterator()
+			assert.True(t, it.Next(), "Expected a first element")
+			reorder(list)
+
+			assert.True(t, it.Next(), "Next() should return true once after %s()", name)
+			_, err := it.Value()
+			assert.ErrorIs(t, err, collections.ErrConcurrentModification, "An iterator opened before %s() should fail", name)
+			assert.False(t, it.Next(), "Next() should return false after reporting the modification")
+		}
+	})
+}
+
+func TestList_Capacitor(t *testing.T) {
+	capacitors := map[string]bool{"ArrayList": true, "SubList": true}
+	for name, newList := range listImplementations {
+		t.Run(name, func(t *testing.T) {
+			list := newList()
+			capacitor, ok := list.(Capacitor)
+			assert.Equal(t, capacitors[name], ok, "Only lists backed by a growable array should be Capacitors")
+			if !ok {
+				return
+			}
+
+			list.AddAll(1, 2, 3)
+			capacitor.EnsureCapacity(100)
+			assert.Equal(t, []int{1, 2, 3}, list.ToSlice(), "EnsureCapacity() should keep the elements")
+			capacitor.TrimToSize()
+			assert.Equal(t, []int{1, 2, 3}, list.ToSlice(), "TrimToSize() should keep the elements")
+		})
+	}
+}
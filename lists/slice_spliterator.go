@@ -0,0 +1,56 @@
+package lists
+
+import "github.com/jorge-barroso/collections"
+
+// sliceSpliterator traverses and splits the range [index, fence) of a slice
+type sliceSpliterator[T any] struct {
+	elements []T
+	index    int // Next element to visit
+	fence    int // One past the last element of the range
+}
+
+// newSliceSpliterator creates a spliterator over the whole slice
+func newSliceSpliterator[T any](elements []T) *sliceSpliterator[T] {
+	return &sliceSpliterator[T]{
+		elements: elements,
+		fence:    len(elements),
+	}
+}
+
+// TryAdvance calls action on the next element of the range
+func (s *sliceSpliterator[T]) TryAdvance(action func(T)) bool {
+	if s.index >= s.fence {
+		return false
+	}
+	action(s.elements[s.index])
+	s.index++
+	return true
+}
+
+// ForEachRemaining calls action on every element left in the range
+func (s *sliceSpliterator[T]) ForEachRemaining(action func(T)) {
+	for ; s.index < s.fence; s.index++ {
+		action(s.elements[s.index])
+	}
+}
+
+// TrySplit hands the first half of the remaining range over to a new spliterator
+func (s *sliceSpliterator[T]) TrySplit() collections.Spliterator[T] {
+	mid := s.index + (s.fence-s.index)/2
+	if mid <= s.index {
+		return nil
+	}
+
+	prefix := &sliceSpliterator[T]{
+		elements: s.elements,
+		index:    s.index,
+		fence:    mid,
+	}
+	s.index = mid
+	return prefix
+}
+
+// EstimateSize returns the exact number of elements left in the range
+func (s *sliceSpliterator[T]) EstimateSize() int {
+	return s.fence - s.index
+}
@@ -0,0 +1,48 @@
+package lists
+
+import (
+	"testing"
+
+	"github.com/jorge-barroso/collections"
+	"github.com/jorge-barroso/collections/collectionstest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArrayList_Spliterator(t *testing.T) {
+	for _, size := range []int{0, 1, 2, 7, 100} {
+		list := NewArrayList[int]()
+		var want []int
+		for i := 0; i < size; i++ {
+			list.Add(i)
+			want = append(want, i)
+		}
+		collectionstest.TestSpliterator(t, list.Spliterator, want, true)
+	}
+}
+
+func TestCopyOnWriteList_Spliterator(t *testing.T) {
+	list := NewCopyOnWriteList[int]()
+	for i := 0; i < 10; i++ {
+		list.Add(i)
+	}
+	collectionstest.TestSpliterator(t, list.Spliterator, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, true)
+
+	// Writes after the spliterator was created must not be visible to it
+	s := list.Spliterator()
+	list.Add(10)
+	assert.Equal(t, 10, s.EstimateSize(), "Spliterator should work on the snapshot taken at creation")
+}
+
+func TestSliceSpliterator_TrySplit(t *testing.T) {
+	s := newSliceSpliterator([]int{1, 2, 3, 4, 5})
+
+	prefix := s.TrySplit()
+	assert.NotNil(t, prefix, "A range of five elements should split")
+	assert.Equal(t, 2, prefix.EstimateSize(), "The prefix should hold the first half")
+	assert.Equal(t, 3, s.EstimateSize(), "The remaining range should hold the second half")
+
+	single := newSliceSpliterator([]int{1})
+	assert.Nil(t, single.TrySplit(), "A single element cannot be split")
+
+	var _ collections.Spliterator[int] = s
+}
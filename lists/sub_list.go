@@ -0,0 +1,316 @@
+package lists
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/jorge-barroso/collections"
+)
+
+// SubList is a live view over a range of an ArrayList.
+// Reads and writes go through to the backing list, and structural changes made through the view
+// update both the list and the view. Once the backing list is structurally modified other than
+// through the view, the view is stale: methods returning an error report collections.ErrConcurrentModification,
+// and the rest panic with it, so a stale view is never mistaken for an empty one.
+type SubList[T any] struct {
+	listOps[T]
+	root     *ArrayList[T]
+	parent   *SubList[T] // View this one was taken from, nil if it was taken from root directly
+	offset   int         // Position in root of the first element of the view
+	size     int
+	modCount int // modCount of root when the view last synchronised with it
+}
+
+// Ensure SubList implements the List, Iterable and Capacitor interfaces
+var _ List[int] = (*SubList[int])(nil)
+var _ collections.Iterable[int] = (*SubList[int])(nil)
+var _ Capacitor = (*SubList[int])(nil)
+
+// Add appends an item to the end of the view, right before the elements of the list that follow it
+func (s *SubList[T]) Add(item T) {
+	s.AddAll(item)
+}
+
+// AddAll appends every given item, in order, to the end of the view
+func (s *SubList[T]) AddAll(items ...T) {
+	s.mustBeUnmodified()
+	end := s.offset + s.size
+	_ = s.root.ReplaceRange(end, end, items...)
+	s.resize(len(items))
+}
+
+// Insert adds an item at the specified index of the view, shifting the following elements to the right
+func (s *SubList[T]) Insert(index int, item T) error {
+	if err := s.checkUnmodified(); err != nil {
+		return err
+	}
+	if err := s.validateInsertIndex(index, s.size); err != nil {
+		return err
+	}
+
+	if err := s.root.Insert(s.offset+index, item); err != nil {
+		return err
+	}
+	s.resize(1)
+	return nil
+}
+
+// Set replaces the element at the specified index of the view
+func (s *SubList[T]) Set(index int, item T) error {
+	if err := s.checkUnmodified(); err != nil {
+		return err
+	}
+	if err := s.validateIndex(index, s.size); err != nil {
+		return err
+	}
+
+	return s.root.Set(s.offset+index, item)
+}
+
+// Get retrieves an element by its index in the view
+func (s *SubList[T]) Get(index int) (T, error) {
+	var zeroValue T
+	if err := s.checkUnmodified(); err != nil {
+		return zeroValue, err
+	}
+	if err := s.validateIndex(index, s.size); err != nil {
+		return zeroValue, err
+	}
+
+	return s.root.elements[s.offset+index], nil
+}
+
+// Remove removes the element at the specified index of the view
+func (s *SubList[T]) Remove(index int) error {
+	if err := s.checkUnmodified(); err != nil {
+		return err
+	}
+	if err := s.validateIndex(index, s.size); err != nil {
+		return err
+	}
+
+	if err := s.root.Remove(s.offset + index); err != nil {
+		return err
+	}
+	s.resize(-1)
+	return nil
+}
+
+// RemoveRange removes the elements of the view between from, inclusive, and to, exclusive
+func (s *SubList[T]) RemoveRange(from, to int) error {
+	return s.ReplaceRange(from, to)
+}
+
+// ReplaceRange replaces the elements of the view between from, inclusive, and to, exclusive, with the given items
+func (s *SubList[T]) ReplaceRange(from, to int, items ...T) error {
+	if err := s.checkUnmodified(); err != nil {
+		return err
+	}
+	if err := s.validateRange(from, to, s.size); err != nil {
+		return err
+	}
+
+	if err := s.root.ReplaceRange(s.offset+from, s.offset+to, items...); err != nil {
+		return err
+	}
+	s.resize(len(items) - (to - from))
+	return nil
+}
+
+// RemoveIf removes every element of the view matching the predicate and returns how many were removed
+func (s *SubList[T]) RemoveIf(predicate func(T) bool) int {
+	s.mustBeUnmodified()
+	removed := s.root.removeIfBetween(s.offset, s.offset+s.size, predicate)
+	s.resize(-removed)
+	return removed
+}
+
+// RetainAll removes every element of the view not equal, according to eq, to any of items and returns how many were removed
+func (s *SubList[T]) RetainAll(items []T, eq func(a, b T) bool) int {
+	return s.RemoveIf(func(item T) bool {
+		return s.indexOf(items, item, eq) < 0
+	})
+}
+
+// IndexOf returns the index in the view of the first element equal to item according to eq, or -1 if there is none
+func (s *SubList[T]) IndexOf(item T, eq func(a, b T) bool) int {
+	return s.indexOf(s.window(), item, eq)
+}
+
+// LastIndexOf returns the index in the view of the last element equal to item according to eq, or -1 if there is none
+func (s *SubList[T]) LastIndexOf(item T, eq func(a, b T) bool) int {
+	return s.lastIndexOf(s.window(), item, eq)
+}
+
+// Contains reports whether the view holds an element equal to item according to eq
+func (s *SubList[T]) Contains(item T, eq func(a, b T) bool) bool {
+	return s.IndexOf(item, eq) >= 0
+}
+
+// Clear removes every element of the view from the backing list
+func (s *SubList[T]) Clear() {
+	s.mustBeUnmodified()
+	_ = s.root.RemoveRange(s.offset, s.offset+s.size)
+	s.resize(-s.size)
+}
+
+// ToSlice returns a copy of the elements of the view
+func (s *SubList[T]) ToSlice() []T {
+	return slices.Clone(s.window())
+}
+
+// Reverse reverses the order of the elements of the view in place, failing open iterators like a structural modification
+func (s *SubList[T]) Reverse() {
+	s.mustBeUnmodified()
+	slices.Reverse(s.window())
+	s.reorder()
+}
+
+// Swap exchanges the elements at the specified indices of the view
+func (s *SubList[T]) Swap(i, j int) error {
+	if err := s.checkUnmodified(); err != nil {
+		return err
+	}
+	if err := s.validateIndex(i, s.size); err != nil {
+		return err
+	}
+	if err := s.validateIndex(j, s.size); err != nil {
+		return err
+	}
+
+	window := s.window()
+	window[i], window[j] = window[j], window[i]
+	return nil
+}
+
+// Sort stably sorts the elements of the view in place according to cmp, failing open iterators like a structural modification
+func (s *SubList[T]) Sort(cmp func(a, b T) int) {
+	s.mustBeUnmodified()
+	timSort(s.window(), cmp)
+	s.reorder()
+}
+
+// IsSorted reports whether the elements of the view are in the order defined by cmp
+func (s *SubList[T]) IsSorted(cmp func(a, b T) int) bool {
+	return slices.IsSortedFunc(s.window(), cmp)
+}
+
+// BinarySearch looks for item in a view sorted according to cmp, returning the position where it was found,
+// or where it would be inserted to keep the view sorted, and whether it was found
+func (s *SubList[T]) BinarySearch(item T, cmp func(a, b T) int) (int, bool) {
+	return slices.BinarySearchFunc(s.window(), item, cmp)
+}
+
+// Size returns the number of elements in the view
+func (s *SubList[T]) Size() int {
+	s.mustBeUnmodified()
+	return s.size
+}
+
+// IsEmpty reports whether the view has no elements
+func (s *SubList[T]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// EnsureCapacity grows the backing list, if needed, so the view can hold at least capacity elements
+// without reallocating
+func (s *SubList[T]) EnsureCapacity(capacity int) {
+	s.mustBeUnmodified()
+	s.root.EnsureCapacity(s.root.Size() - s.size + capacity)
+}
+
+// TrimToSize shrinks the backing array of the list to its current size
+func (s *SubList[T]) TrimToSize() {
+	s.mustBeUnmodified()
+	s.root.TrimToSize()
+}
+
+// SubList returns a live view of the elements of this view between from, inclusive, and to, exclusive
+func (s *SubList[T]) SubList(from, to int) (*SubList[T], error) {
+	if err := s.checkUnmodified(); err != nil {
+		return nil, err
+	}
+	if err := s.validateRange(from, to, s.size); err != nil {
+		return nil, err
+	}
+
+	return &SubList[T]{
+		root:     s.root,
+		parent:   s,
+		offset:   s.offset + from,
+		size:     to - from,
+		modCount: s.modCount,
+	}, nil
+}
+
+// NewIterator creates and returns a new iterator over the view
+func (s *SubList[T]) NewIterator() collections.Iterator[T] {
+	return s.NewListIterator()
+}
+
+// NewListIterator returns an iterator that can also remove and replace elements of the view
+func (s *SubList[T]) NewListIterator() ListIterator[T] {
+	return &SubListIterator[T]{
+		index:            -1,
+		list:             s,
+		expectedModCount: s.modCount,
+	}
+}
+
+// All returns an iter.Seq over the elements of the view, in index order.
+// It panics with collections.ErrConcurrentModification if the backing list is structurally modified
+// other than through the view during the iteration.
+func (s *SubList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < s.Size(); i++ {
+			if !yield(s.root.elements[s.offset+i]) {
+				return
+			}
+		}
+	}
+}
+
+// Spliterator returns a splittable traversal over the current elements of the view.
+// The backing list must not be structurally modified while the spliterator is in use.
+func (s *SubList[T]) Spliterator() collections.Spliterator[T] {
+	return newSliceSpliterator(s.window())
+}
+
+// window returns the elements of the view as a slice sharing the backing array of the list
+func (s *SubList[T]) window() []T {
+	s.mustBeUnmodified()
+	return s.root.elements[s.offset : s.offset+s.size : s.offset+s.size]
+}
+
+// resize records a structural change of delta elements made through the view, on the view itself
+// and on every view it was taken from
+func (s *SubList[T]) resize(delta int) {
+	for view := s; view != nil; view = view.parent {
+		view.size += delta
+		view.modCount = s.root.modCount
+	}
+}
+
+// reorder records that the elements of the view were reordered through it, which open iterators
+// and other views of the list see as a structural modification while this view and its parents stay valid
+func (s *SubList[T]) reorder() {
+	s.root.modCount++
+	s.resize(0)
+}
+
+// checkUnmodified returns collections.ErrConcurrentModification if the backing list has been
+// structurally modified other than through the view
+func (s *SubList[T]) checkUnmodified() error {
+	if s.root.modCount != s.modCount {
+		return collections.ErrConcurrentModification
+	}
+	return nil
+}
+
+// mustBeUnmodified panics with collections.ErrConcurrentModification if the backing list has been
+// structurally modified other than through the view, for the methods that cannot return the error
+func (s *SubList[T]) mustBeUnmodified() {
+	if err := s.checkUnmodified(); err != nil {
+		panic(err)
+	}
+}
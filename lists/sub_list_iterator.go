@@ -0,0 +1,86 @@
+package lists
+
+import "github.com/jorge-barroso/collections"
+
+// SubListIterator struct for SubList
+type SubListIterator[T any] struct {
+	index            int
+	list             *SubList[T]
+	removed          bool // Set when the element under the cursor has been removed through the iterator
+	expectedModCount int  // modCount of the backing list when the iterator last synchronised with it
+	failed           bool // Set once a concurrent modification has been reported by Next
+}
+
+// Ensure SubListIterator implements ListIterator
+var _ ListIterator[int] = (*SubListIterator[int])(nil)
+
+// Next advances the iterator to the next element of the view
+func (sIt *SubListIterator[T]) Next() bool {
+	if sIt.modified() {
+		if sIt.failed {
+			return false
+		}
+		sIt.failed = true
+		return true
+	}
+
+	if sIt.index < sIt.list.size-1 {
+		sIt.index++
+		sIt.removed = false
+		return true
+	}
+
+	return false
+}
+
+// Value returns the element the iterator is positioned on
+func (sIt *SubListIterator[T]) Value() (T, error) {
+	var zero T
+	if err := sIt.checkPositioned(); err != nil {
+		return zero, err
+	}
+
+	return sIt.list.root.elements[sIt.list.offset+sIt.index], nil
+}
+
+// Remove deletes the element the iterator is positioned on, shifting the following elements left
+func (sIt *SubListIterator[T]) Remove() error {
+	if err := sIt.checkPositioned(); err != nil {
+		return err
+	}
+
+	if err := sIt.list.Remove(sIt.index); err != nil {
+		return err
+	}
+	// The next element has shifted into the current index
+	sIt.index--
+	sIt.removed = true
+	sIt.expectedModCount = sIt.list.modCount
+	return nil
+}
+
+// Set replaces the element the iterator is positioned on
+func (sIt *SubListIterator[T]) Set(value T) error {
+	if err := sIt.checkPositioned(); err != nil {
+		return err
+	}
+
+	sIt.list.root.elements[sIt.list.offset+sIt.index] = value
+	return nil
+}
+
+// checkPositioned returns an error unless the iterator sits on a valid, unmodified element
+func (sIt *SubListIterator[T]) checkPositioned() error {
+	if sIt.modified() {
+		return collections.ErrConcurrentModification
+	}
+	if sIt.index < 0 || sIt.removed {
+		return collections.ErrNoSuchElement
+	}
+	return nil
+}
+
+// modified reports whether the backing list has been structurally modified behind the iterator
+func (sIt *SubListIterator[T]) modified() bool {
+	return sIt.list.root.modCount != sIt.expectedModCount
+}
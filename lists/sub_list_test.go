@@ -0,0 +1,198 @@
+package lists
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+
+	"github.com/jorge-barroso/collections"
+	"github.com/jorge-barroso/collections/collectionstest"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSubListFixture(t *testing.T) (*ArrayList[int], *SubList[int]) {
+	list := NewArrayList[int]()
+	list.AddAll(0, 1, 2, 3, 4, 5, 6, 7)
+	view, err := list.SubList(2, 6)
+	assert.NoError(t, err, "Unexpected error when taking a sub-list")
+	return list, view
+}
+
+func TestSubList_Bounds(t *testing.T) {
+	list, view := newSubListFixture(t)
+	assert.Equal(t, []int{2, 3, 4, 5}, view.ToSlice(), "Elements mismatch in the view")
+
+	_, err := list.SubList(-1, 2)
+	assert.Error(t, err, "Expected error for a negative start")
+	_, err = list.SubList(3, 2)
+	assert.Error(t, err, "Expected error for a start past the end")
+	_, err = list.SubList(0, 9)
+	assert.Error(t, err, "Expected error for an end past the size of the list")
+
+	_, err = view.Get(4)
+	assert.Error(t, err, "Expected error when getting past the end of the view")
+	assert.Error(t, view.Set(-1, 0), "Expected error when setting before the start of the view")
+}
+
+func TestSubList_WritesGoThrough(t *testing.T) {
+	list, view := newSubListFixture(t)
+
+	assert.NoError(t, view.Set(0, 20), "Unexpected error when setting through the view")
+	assert.NoError(t, list.Set(5, 50), "Unexpected error when setting through the list")
+	value, err := view.Get(3)
+	assert.NoError(t, err, "Unexpected error when getting through the view")
+	assert.Equal(t, 50, value, "Non-structural changes to the list should be visible in the view")
+
+	view.Add(55)
+	assert.NoError(t, view.Remove(1), "Unexpected error when removing through the view")
+	assert.NoError(t, view.Insert(0, 10), "Unexpected error when inserting through the view")
+	view.Reverse()
+	assert.Equal(t, []int{55, 50, 4, 20, 10}, view.ToSlice(), "Elements mismatch in the view")
+	assert.Equal(t, []int{0, 1, 55, 50, 4, 20, 10, 6, 7}, list.ToSlice(), "Changes through the view should be visible in the list")
+
+	view.Clear()
+	assert.True(t, view.IsEmpty(), "Expected an empty view after Clear()")
+	assert.Equal(t, []int{0, 1, 6, 7}, list.ToSlice(), "Clear() should only remove the elements of the view")
+}
+
+func TestSubList_Nested(t *testing.T) {
+	list, view := newSubListFixture(t)
+	inner, err := view.SubList(1, 3)
+	assert.NoError(t, err, "Unexpected error when taking a nested sub-list")
+	assert.Equal(t, []int{3, 4}, inner.ToSlice(), "Elements mismatch in the nested view")
+
+	inner.AddAll(40, 41)
+	assert.Equal(t, 4, inner.Size(), "Nested view size mismatch")
+	assert.Equal(t, 6, view.Size(), "Changes through a nested view should resize the views it was taken from")
+	assert.Equal(t, []int{2, 3, 4, 40, 41, 5}, view.ToSlice(), "Elements mismatch in the enclosing view")
+	assert.Equal(t, 10, list.Size(), "List size mismatch after adding through a nested view")
+
+	sibling, err := view.SubList(0, 1)
+	assert.NoError(t, err, "Unexpected error when taking a sibling sub-list")
+	assert.NoError(t, sibling.Remove(0), "Unexpected error when removing through the sibling")
+	_, err = inner.Get(0)
+	assert.ErrorIs(t, err, collections.ErrConcurrentModification, "Changes through a sibling view should invalidate the other one")
+	assert.Equal(t, []int{3, 4, 40, 41, 5}, view.ToSlice(), "The enclosing view should stay valid")
+}
+
+func TestSubList_FailFast(t *testing.T) {
+	list, view := newSubListFixture(t)
+	list.Add(8)
+
+	_, err := view.Get(0)
+	assert.ErrorIs(t, err, collections.ErrConcurrentModification, "Get() should report the structural modification")
+	assert.ErrorIs(t, view.Set(0, 0), collections.ErrConcurrentModification, "Set() should report the structural modification")
+	_, err = view.SubList(0, 1)
+	assert.ErrorIs(t, err, collections.ErrConcurrentModification, "SubList() should report the structural modification")
+	assert.ErrorIs(t, view.Insert(0, 0), collections.ErrConcurrentModification, "Insert() should report the structural modification")
+	assert.ErrorIs(t, view.Remove(0), collections.ErrConcurrentModification, "Remove() should report the structural modification")
+	assert.ErrorIs(t, view.RemoveRange(0, 1), collections.ErrConcurrentModification, "RemoveRange() should report the structural modification")
+	assert.ErrorIs(t, view.ReplaceRange(0, 1, 9), collections.ErrConcurrentModification, "ReplaceRange() should report the structural modification")
+	assert.ErrorIs(t, view.Swap(0, 1), collections.ErrConcurrentModification, "Swap() should report the structural modification")
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8}, list.ToSlice(), "Failed operations should leave the list unchanged")
+
+	list, view = newSubListFixture(t)
+	collectionstest.TestFailFastIterable[int](t, view, func() { list.Add(8) })
+}
+
+func TestSubList_StaleViewPanics(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+	calls := map[string]func(view *SubList[int]){
+		"Size":           func(view *SubList[int]) { view.Size() },
+		"IsEmpty":        func(view *SubList[int]) { view.IsEmpty() },
+		"ToSlice":        func(view *SubList[int]) { view.ToSlice() },
+		"All":            func(view *SubList[int]) { _ = slices.Collect(view.All()) },
+		"IndexOf":        func(view *SubList[int]) { view.IndexOf(3, eq) },
+		"LastIndexOf":    func(view *SubList[int]) { view.LastIndexOf(3, eq) },
+		"Contains":       func(view *SubList[int]) { view.Contains(3, eq) },
+		"IsSorted":       func(view *SubList[int]) { view.IsSorted(cmp.Compare[int]) },
+		"BinarySearch":   func(view *SubList[int]) { view.BinarySearch(3, cmp.Compare[int]) },
+		"Spliterator":    func(view *SubList[int]) { view.Spliterator() },
+		"Add":            func(view *SubList[int]) { view.Add(9) },
+		"AddAll":         func(view *SubList[int]) { view.AddAll(9, 9) },
+		"Clear":          func(view *SubList[int]) { view.Clear() },
+		"Reverse":        func(view *SubList[int]) { view.Reverse() },
+		"Sort":           func(view *SubList[int]) { view.Sort(collections.ReverseOrder[int]()) },
+		"RemoveIf":       func(view *SubList[int]) { view.RemoveIf(func(int) bool { return true }) },
+		"RetainAll":      func(view *SubList[int]) { view.RetainAll(nil, eq) },
+		"EnsureCapacity": func(view *SubList[int]) { view.EnsureCapacity(100) },
+		"TrimToSize":     func(view *SubList[int]) { view.TrimToSize() },
+	}
+
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			list, view := newSubListFixture(t)
+			list.Add(8)
+
+			assert.PanicsWithError(t, collections.ErrConcurrentModification.Error(), func() { call(view) },
+				"%s() should fail fast on a stale view", name)
+			assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8}, list.ToSlice(), "A stale view should leave the list unchanged")
+		})
+	}
+}
+
+func TestSubList_AllPanicsOnStructuralModification(t *testing.T) {
+	list, view := newSubListFixture(t)
+	var seen []int
+	assert.PanicsWithError(t, collections.ErrConcurrentModification.Error(), func() {
+		for value := range view.All() {
+			seen = append(seen, value)
+			list.Add(value)
+		}
+	}, "Modifying the list while ranging over the view should fail fast")
+	assert.Equal(t, []int{2}, seen, "The iteration should stop once the list is modified")
+}
+
+func TestSubList_IteratorConformance(t *testing.T) {
+	_, view := newSubListFixture(t)
+	collectionstest.TestIterable[int](t, view, []int{2, 3, 4, 5})
+	assert.Equal(t, []int{2, 3, 4, 5}, slices.Collect(view.All()), "Elements mismatch when ranging over the view")
+}
+
+func TestSubListIterator_RemoveAndSet(t *testing.T) {
+	list, view := newSubListFixture(t)
+
+	iter := view.NewListIterator()
+	for iter.Next() {
+		value, err := iter.Value()
+		assert.NoError(t, err, "Unexpected error during iteration")
+		if value%2 == 0 {
+			assert.NoError(t, iter.Remove(), "Unexpected error when removing through the iterator")
+		} else {
+			assert.NoError(t, iter.Set(value*10), "Unexpected error when setting through the iterator")
+		}
+	}
+
+	assert.Equal(t, []int{30, 50}, view.ToSlice(), "Elements mismatch in the view after iterating")
+	assert.Equal(t, []int{0, 1, 30, 50, 6, 7}, list.ToSlice(), "Elements mismatch in the list after iterating through the view")
+}
+
+func TestSubList_Capacity(t *testing.T) {
+	list, view := newSubListFixture(t)
+	list.TrimToSize()
+
+	view.EnsureCapacity(20)
+	assert.GreaterOrEqual(t, list.Capacity(), 24, "EnsureCapacity() should make room in the backing list for the view to grow")
+	assert.Equal(t, []int{2, 3, 4, 5}, view.ToSlice(), "EnsureCapacity() should keep the elements of the view")
+
+	view.TrimToSize()
+	assert.Equal(t, 8, list.Capacity(), "TrimToSize() should shrink the backing list to its size")
+	assert.Equal(t, 4, view.Size(), "Capacity control should not invalidate the view")
+}
+
+func TestSubList_Reordering(t *testing.T) {
+	list, view := newSubListFixture(t)
+	inner, err := view.SubList(0, 2)
+	assert.NoError(t, err, "Unexpected error when taking a nested sub-list")
+
+	inner.Reverse()
+	view.Sort(collections.ReverseOrder[int]())
+	assert.Equal(t, []int{5, 4, 3, 2}, view.ToSlice(), "Sorting through the view should keep it valid")
+	assert.Equal(t, []int{0, 1, 5, 4, 3, 2, 6, 7}, list.ToSlice(), "Sorting through the view should reach the list")
+	_, err = inner.Get(0)
+	assert.ErrorIs(t, err, collections.ErrConcurrentModification, "Sorting through a parent view should invalidate nested ones")
+
+	list.Sort(cmp.Compare[int])
+	_, err = view.Get(0)
+	assert.ErrorIs(t, err, collections.ErrConcurrentModification, "Sorting the list should invalidate its views")
+}
@@ -0,0 +1,166 @@
+package lists
+
+const (
+	// minMerge is the size below which timSort falls back to a plain binary insertion sort
+	minMerge = 32
+)
+
+// timSort stably sorts values in place.
+// It finds the runs that are already in order, extends the short ones with a binary insertion sort,
+// and merges them pairwise keeping the stack of pending runs balanced, so partially sorted input
+// costs close to O(n) while the worst case stays O(n log n).
+func timSort[T any](values []T, cmp func(a, b T) int) {
+	n := len(values)
+	if n < 2 {
+		return
+	}
+	if n < minMerge {
+		binaryInsertionSort(values, countRunAndMakeAscending(values, cmp), cmp)
+		return
+	}
+
+	s := &timSortState[T]{values: values, cmp: cmp}
+	minRun := minRunLength(n)
+	for lo := 0; lo < n; {
+		runLength := countRunAndMakeAscending(values[lo:], cmp)
+		if runLength < minRun {
+			// Extend the run to minRun elements, or to the end of the input
+			forced := min(minRun, n-lo)
+			binaryInsertionSort(values[lo:lo+forced], runLength, cmp)
+			runLength = forced
+		}
+
+		s.runs = append(s.runs, timSortRun{start: lo, length: runLength})
+		s.mergeCollapse()
+		lo += runLength
+	}
+	s.mergeForceCollapse()
+}
+
+// timSortRun is a range of values that is already sorted
+type timSortRun struct {
+	start, length int
+}
+
+// timSortState holds the runs waiting to be merged and the scratch space used to merge them
+type timSortState[T any] struct {
+	values []T
+	cmp    func(a, b T) int
+	runs   []timSortRun
+	buffer []T
+}
+
+// mergeCollapse merges the runs at the top of the stack until their lengths shrink faster than
+// the Fibonacci sequence, which keeps the merges balanced and the stack logarithmic in size
+func (s *timSortState[T]) mergeCollapse() {
+	for len(s.runs) > 1 {
+		n := len(s.runs) - 2
+		if (n > 0 && s.runs[n-1].length <= s.runs[n].length+s.runs[n+1].length) ||
+			(n > 1 && s.runs[n-2].length <= s.runs[n-1].length+s.runs[n].length) {
+			if s.runs[n-1].length < s.runs[n+1].length {
+				n--
+			}
+		} else if s.runs[n].length > s.runs[n+1].length {
+			return
+		}
+		s.mergeAt(n)
+	}
+}
+
+// mergeForceCollapse merges every pending run into one
+func (s *timSortState[T]) mergeForceCollapse() {
+	for len(s.runs) > 1 {
+		n := len(s.runs) - 2
+		if n > 0 && s.runs[n-1].length < s.runs[n+1].length {
+			n--
+		}
+		s.mergeAt(n)
+	}
+}
+
+// mergeAt merges the runs at positions i and i+1 of the stack, which are adjacent in values
+func (s *timSortState[T]) mergeAt(i int) {
+	left, right := s.runs[i], s.runs[i+1]
+	s.runs[i].length = left.length + right.length
+	s.runs = append(s.runs[:i+1], s.runs[i+2:]...)
+
+	mid := right.start
+	// Nothing to do when the runs are already in order
+	if s.cmp(s.values[mid-1], s.values[mid]) <= 0 {
+		return
+	}
+	s.merge(s.values[left.start:right.start+right.length], mid-left.start)
+}
+
+// merge stably merges the sorted halves values[:mid] and values[mid:], copying only the left one aside
+func (s *timSortState[T]) merge(values []T, mid int) {
+	s.buffer = append(s.buffer[:0], values[:mid]...)
+	left, right, out := 0, mid, 0
+	for left < len(s.buffer) && right < len(values) {
+		// Taking from the left on ties keeps the sort stable
+		if s.cmp(values[right], s.buffer[left]) < 0 {
+			values[out] = values[right]
+			right++
+		} else {
+			values[out] = s.buffer[left]
+			left++
+		}
+		out++
+	}
+	copy(values[out:], s.buffer[left:])
+
+	// Do not keep the merged values reachable through the scratch space
+	clear(s.buffer)
+}
+
+// countRunAndMakeAscending returns the length of the run at the start of values,
+// reversing it first if it is strictly descending
+func countRunAndMakeAscending[T any](values []T, cmp func(a, b T) int) int {
+	end := 1
+	if end == len(values) {
+		return end
+	}
+
+	if cmp(values[end], values[0]) < 0 {
+		// Only strictly descending runs can be reversed without breaking stability
+		for end++; end < len(values) && cmp(values[end], values[end-1]) < 0; end++ {
+		}
+		for i, j := 0, end-1; i < j; i, j = i+1, j-1 {
+			values[i], values[j] = values[j], values[i]
+		}
+	} else {
+		for end++; end < len(values) && cmp(values[end], values[end-1]) >= 0; end++ {
+		}
+	}
+	return end
+}
+
+// binaryInsertionSort sorts values whose first sorted elements are already in order,
+// placing each of the others after every equivalent element before it
+func binaryInsertionSort[T any](values []T, sorted int, cmp func(a, b T) int) {
+	for i := max(sorted, 1); i < len(values); i++ {
+		pivot := values[i]
+		lo, hi := 0, i
+		for lo < hi {
+			mid := int(uint(lo+hi) >> 1)
+			if cmp(pivot, values[mid]) < 0 {
+				hi = mid
+			} else {
+				lo = mid + 1
+			}
+		}
+		copy(values[lo+1:i+1], values[lo:i])
+		values[lo] = pivot
+	}
+}
+
+// minRunLength returns the minimum run length for an input of n elements, chosen so that
+// n divided by it is close to, but no larger than, a power of two
+func minRunLength(n int) int {
+	r := 0
+	for n >= minMerge {
+		r |= n & 1
+		n >>= 1
+	}
+	return n + r
+}
@@ -0,0 +1,74 @@
+package lists
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// sortEntry pairs a sort key with its original position so stability can be checked
+type sortEntry struct {
+	key, position int
+}
+
+func compareSortEntries(a, b sortEntry) int {
+	return cmp.Compare(a.key, b.key)
+}
+
+// sortInputs builds inputs of different shapes, with few distinct keys so that ties are common
+func sortInputs(n int) map[string][]sortEntry {
+	random := rand.New(rand.NewSource(int64(n)))
+	inputs := map[string][]sortEntry{
+		"Random":     make([]sortEntry, n),
+		"Ascending":  make([]sortEntry, n),
+		"Descending": make([]sortEntry, n),
+		"Sawtooth":   make([]sortEntry, n),
+	}
+	for i := 0; i < n; i++ {
+		inputs["Random"][i] = sortEntry{key: random.Intn(n/4 + 1), position: i}
+		inputs["Ascending"][i] = sortEntry{key: i / 3, position: i}
+		inputs["Descending"][i] = sortEntry{key: (n - i) / 3, position: i}
+		inputs["Sawtooth"][i] = sortEntry{key: i % 50, position: i}
+	}
+	return inputs
+}
+
+func TestTimSort_MatchesStableSort(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 31, 32, 33, 100, 1000, 5000} {
+		for shape, input := range sortInputs(n) {
+			want := slices.Clone(input)
+			slices.SortStableFunc(want, compareSortEntries)
+
+			got := slices.Clone(input)
+			timSort(got, compareSortEntries)
+			assert.Equal(t, want, got, "timSort mismatch for %d %s elements", n, shape)
+		}
+	}
+}
+
+func TestLinkedList_SortIsStable(t *testing.T) {
+	for shape, input := range sortInputs(500) {
+		want := slices.Clone(input)
+		slices.SortStableFunc(want, compareSortEntries)
+
+		list := NewLinkedList[sortEntry]()
+		list.AddAll(input...)
+		list.Sort(compareSortEntries)
+		assert.Equal(t, want, list.ToSlice(), "Sort() mismatch for %s elements", shape)
+
+		slices.Reverse(want)
+		assert.Equal(t, want, slices.Collect(list.Backward()), "Prev links mismatch after sorting %s elements", shape)
+	}
+}
+
+func TestMinRunLength(t *testing.T) {
+	assert.Equal(t, 31, minRunLength(31), "Short inputs should be a single run")
+	assert.Equal(t, 16, minRunLength(1024), "Powers of two should split into equal power of two runs")
+	for n := minMerge; n < 10000; n += 97 {
+		minRun := minRunLength(n)
+		assert.True(t, minRun >= minMerge/2 && minRun <= minMerge, "minRunLength(%d) = %d out of range", n, minRun)
+	}
+}
@@ -0,0 +1,98 @@
+package maps
+
+// computeOps is the minimal set of unsynchronised operations the compute family is built on.
+// Maps needing synchronisation implement it on a view that only exists while the lock is held.
+type computeOps[K any, V any] interface {
+	lookup(key K) (V, bool)
+	store(key K, value V) bool // Reports false, leaving the map unchanged, if the map does not accept the key
+	remove(key K)
+}
+
+// putIfAbsent associates value with key unless the key is already present, returning the value
+// associated with key afterwards, the zero value if the map rejected the key, and whether it was already present
+func putIfAbsent[K any, V any](m computeOps[K, V], key K, value V) (V, bool) {
+	if existing, ok := m.lookup(key); ok {
+		return existing, true
+	}
+	if !m.store(key, value) {
+		var zero V
+		return zero, false
+	}
+	return value, false
+}
+
+// computeIfAbsent associates the result of mapping with key unless the key is already present,
+// returning the value associated with key afterwards, or the zero value if the map rejected the key
+func computeIfAbsent[K any, V any](m computeOps[K, V], key K, mapping func(key K) V) V {
+	if existing, ok := m.lookup(key); ok {
+		return existing
+	}
+	value := mapping(key)
+	if !m.store(key, value) {
+		var zero V
+		return zero
+	}
+	return value
+}
+
+// computeIfPresent replaces the value associated with key, if any, with the result of remapping,
+// removing the key when remapping returns false
+func computeIfPresent[K any, V any](m computeOps[K, V], key K, remapping func(key K, value V) (V, bool)) (V, bool) {
+	existing, ok := m.lookup(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return apply(m, key, true, func() (V, bool) { return remapping(key, existing) })
+}
+
+// compute replaces the value associated with key with the result of remapping, which also learns
+// whether the key was present, removing the key when remapping returns false
+func compute[K any, V any](m computeOps[K, V], key K, remapping func(key K, value V, present bool) (V, bool)) (V, bool) {
+	existing, ok := m.lookup(key)
+	return apply(m, key, ok, func() (V, bool) { return remapping(key, existing, ok) })
+}
+
+// merge associates value with key if the key is absent, otherwise combines both values with merging,
+// removing the key when merging returns false
+func merge[K any, V any](m computeOps[K, V], key K, value V, merging func(old, value V) (V, bool)) (V, bool) {
+	existing, ok := m.lookup(key)
+	if !ok {
+		return apply(m, key, false, func() (V, bool) { return value, true })
+	}
+	return apply(m, key, true, func() (V, bool) { return merging(existing, value) })
+}
+
+// replace associates newValue with key only if it is currently associated with a value equal to oldValue
+func replace[K any, V any](m computeOps[K, V], key K, oldValue, newValue V, eq func(a, b V) bool) bool {
+	existing, ok := m.lookup(key)
+	if !ok || !eq(existing, oldValue) {
+		return false
+	}
+	return m.store(key, newValue)
+}
+
+// removeIfEqual removes key only if it is currently associated with a value equal to expected
+func removeIfEqual[K any, V any](m computeOps[K, V], key K, expected V, eq func(a, b V) bool) bool {
+	existing, ok := m.lookup(key)
+	if !ok || !eq(existing, expected) {
+		return false
+	}
+	m.remove(key)
+	return true
+}
+
+// apply stores the value produced by remap, or removes key if remap asks not to keep it.
+// It reports whether a value is associated with key afterwards, which is not the case if the map rejected the key.
+func apply[K any, V any](m computeOps[K, V], key K, present bool, remap func() (V, bool)) (V, bool) {
+	value, keep := remap()
+	if keep {
+		if m.store(key, value) {
+			return value, true
+		}
+	} else if present {
+		m.remove(key)
+	}
+	var zero V
+	return zero, false
+}
@@ -2,27 +2,23 @@ package maps
 
 import (
 	"errors"
+	"iter"
+	"sync"
+	"sync/atomic"
+
 	"github.com/jorge-barroso/collections"
 	"github.com/jorge-barroso/collections/hashing"
-	"sync"
 )
 
-// ShardCount determines the number of segments in the concurrent map
-const ShardCount = 16
-
-// mapShard represents a single shard of the concurrent map
-type mapShard[K comparable, V any] struct {
-	items map[K]V
-	sync.RWMutex
-}
-
-// ConcurrentHashMap implements a thread-safe map using multiple shards
-type ConcurrentHashMap[K comparable, V any] struct {
-	shards    [ShardCount]mapShard[K, V]
-	size      int64
-	sizeMutex sync.RWMutex
-	//globalMux sync.RWMutex // New global lock
-	hashFunc hashing.HashFunction[K]
+// ConcurrentHashMap implements a thread-safe map using multiple shards.
+// The shards are split in two, one at a time, when one of them holds too many entries or its lock
+// is contended too often, so only the goroutines using the shard being split ever wait for it.
+type ConcurrentHashMap[K any, V any] struct {
+	table    atomic.Pointer[shardTable[K, V]] // Current shards, replaced as a whole once a split completes
+	resizing sync.Mutex                       // Held while the shards are being split
+	size     *stripedCounter                  // Number of entries, spread so that writers to different shards do not contend
+	hasher   hashing.Hasher[K]
+	config   concurrentHashMapConfig
 }
 
 // Ensure ConcurrentHashMap implements both Map and Iterable interfaces
@@ -30,53 +26,129 @@ var _ Map[string, int] = (*ConcurrentHashMap[string, int])(nil)
 var _ collections.Iterable[Entry[string, int]] = (*ConcurrentHashMap[string, int])(nil)
 
 // NewConcurrentHashMap creates a new ConcurrentHashMap with default hash function
-func NewConcurrentHashMap[K comparable, V any]() *ConcurrentHashMap[K, V] {
-	return NewConcurrentHashMapWithHash[K, V](hashing.NewFNVHash[K]())
+func NewConcurrentHashMap[K comparable, V any](options ...ConcurrentHashMapOption) *ConcurrentHashMap[K, V] {
+	return NewConcurrentHashMapWithHash[K, V](hashing.NewFNVHash[K](), options...)
 }
 
 // NewConcurrentHashMapWithHash creates a new ConcurrentHashMap with a custom hash function
-func NewConcurrentHashMapWithHash[K comparable, V any](hashFunc hashing.HashFunction[K]) *ConcurrentHashMap[K, V] {
+func NewConcurrentHashMapWithHash[K comparable, V any](hashFunc hashing.HashFunction[K], options ...ConcurrentHashMapOption) *ConcurrentHashMap[K, V] {
+	config := newConcurrentHashMapConfig(options)
+	if config.randomSeed {
+		hashFunc = randomlySeeded(hashFunc)
+	}
+	return newConcurrentHashMap[K, V](hashing.NewComparableHasher(hashFunc), config)
+}
+
+// NewConcurrentHashMapWithHasher creates a new ConcurrentHashMap whose keys are hashed and compared by hasher,
+// so that they do not need to be comparable. WithRandomSeed does not apply to hashers, which should
+// be built from seeded hash functions when the keys are untrusted.
+func NewConcurrentHashMapWithHasher[K any, V any](hasher hashing.Hasher[K], options ...ConcurrentHashMapOption) *ConcurrentHashMap[K, V] {
+	return newConcurrentHashMap[K, V](hasher, newConcurrentHashMapConfig(options))
+}
+
+// newConcurrentHashMap creates an empty ConcurrentHashMap with the given hasher and settings
+func newConcurrentHashMap[K any, V any](hasher hashing.Hasher[K], config concurrentHashMapConfig) *ConcurrentHashMap[K, V] {
 	cm := &ConcurrentHashMap[K, V]{
-		hashFunc: hashFunc,
+		size:   newStripedCounter(),
+		hasher: hasher,
+		config: config,
 	}
-	for i := 0; i < ShardCount; i++ {
-		cm.shards[i] = mapShard[K, V]{
-			items: make(map[K]V),
+	cm.table.Store(newShardTable[K, V](config.shardCount, hasher))
+	return cm
+}
+
+// ShardCount returns the number of shards the map is currently split into
+func (cm *ConcurrentHashMap[K, V]) ShardCount() int {
+	return len(cm.table.Load().shards)
+}
+
+// readShard acquires the read lock of the shard holding key, which it returns along with the hash of the key.
+// The returned function releases the lock.
+func (cm *ConcurrentHashMap[K, V]) readShard(key K) (*mapShard[K, V], uint64, func()) {
+	hash := cm.hasher.Hash(key)
+	table := cm.table.Load()
+	for {
+		shard := table.shardFor(hash)
+		shard.RLock()
+		if shard.forward == nil {
+			return shard, hash, shard.RUnlock
 		}
+		// The shard was split after the table was loaded, look the key up in the larger table
+		table = shard.forward
+		shard.RUnlock()
 	}
-	return cm
 }
 
-// getShard returns the appropriate shard for a given key
-func (cm *ConcurrentHashMap[K, V]) getShard(key K) *mapShard[K, V] {
-	hashCode := cm.hashFunc.Hash(key)
-	return &cm.shards[hashCode%ShardCount]
+// lockShard acquires the write lock of the shard holding key, the returned function releases it
+// and splits the shards if this one has become too large or too contended
+func (cm *ConcurrentHashMap[K, V]) lockShard(key K) (lockedShard[K, V], func()) {
+	hash := cm.hasher.Hash(key)
+	table := cm.table.Load()
+	for {
+		shard := table.shardFor(hash)
+		contended := !shard.TryLock()
+		if contended {
+			shard.Lock()
+		}
+		if shard.forward != nil {
+			// The shard was split while we were waiting for it, look the key up in the larger table
+			table = shard.forward
+			shard.Unlock()
+			continue
+		}
+
+		overloaded := shard.recordLock(contended)
+		return lockedShard[K, V]{cm: cm, shard: shard, hash: hash}, func() {
+			overloaded = overloaded || shard.items.len() > cm.config.loadThreshold
+			shard.Unlock()
+			if overloaded {
+				cm.grow(table)
+			}
+		}
+	}
 }
 
-// Put adds or updates a key-value pair
-func (cm *ConcurrentHashMap[K, V]) Put(key K, value V) {
-	shard := cm.getShard(key)
-	shard.Lock()         // Acquire write lock to modify shard
-	defer shard.Unlock() // Release write lock after operation
+// grow doubles the number of shards of table unless it has already been replaced or is as large as allowed.
+// Each shard is split in turn under its own write lock and forwards to the new table from then on,
+// which is only published once every shard has been split.
+func (cm *ConcurrentHashMap[K, V]) grow(table *shardTable[K, V]) {
+	// Whoever is already splitting the shards will leave them large enough
+	if !cm.resizing.TryLock() {
+		return
+	}
+	defer cm.resizing.Unlock()
+
+	if cm.table.Load() != table || len(table.shards) >= cm.config.maxShardCount {
+		return
+	}
 
-	_, exists := shard.items[key]
-	if !exists {
-		// Update total size safely if the key is new
-		cm.sizeMutex.Lock()
-		cm.size++
-		cm.sizeMutex.Unlock()
+	next := newShardTable[K, V](len(table.shards)*2, cm.hasher)
+	for _, shard := range table.shards {
+		shard.Lock()
+		for _, entry := range shard.items.entries {
+			next.shardFor(entry.hash).items.insert(entry.hash, entry.key, entry.value)
+		}
+		shard.items = nil
+		shard.forward = next
+		shard.Unlock()
 	}
+	cm.table.Store(next)
+}
+
+// Put adds or updates a key-value pair
+func (cm *ConcurrentHashMap[K, V]) Put(key K, value V) {
+	shard, unlock := cm.lockShard(key)
+	defer unlock()
 
-	shard.items[key] = value // Add or update the key-value pair in the shard
+	shard.store(key, value)
 }
 
 // Get retrieves a value by key
 func (cm *ConcurrentHashMap[K, V]) Get(key K) (V, error) {
-	shard := cm.getShard(key)
-	shard.RLock()         // Acquire read lock to safely access shard
-	defer shard.RUnlock() // Release read lock after operation
+	shard, hash, unlock := cm.readShard(key)
+	defer unlock()
 
-	value, ok := shard.items[key]
+	value, ok := shard.items.get(hash, key)
 	if !ok {
 		var zero V
 		return zero, errors.New("key not found")
@@ -84,61 +156,131 @@ func (cm *ConcurrentHashMap[K, V]) Get(key K) (V, error) {
 	return value, nil
 }
 
+// GetOrDefault retrieves the value associated with a key, or defaultValue if the key is not in the map
+func (cm *ConcurrentHashMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	shard, hash, unlock := cm.readShard(key)
+	defer unlock()
+
+	if value, ok := shard.items.get(hash, key); ok {
+		return value
+	}
+	return defaultValue
+}
+
+// PutAll inserts or updates every key-value pair of other, each pair is inserted atomically but not the whole batch
+func (cm *ConcurrentHashMap[K, V]) PutAll(other Map[K, V]) {
+	for key, value := range other.All() {
+		cm.Put(key, value)
+	}
+}
+
 // Remove deletes a key-value pair
 func (cm *ConcurrentHashMap[K, V]) Remove(key K) error {
-	shard := cm.getShard(key)
-	shard.Lock()         // Acquire write lock to modify shard
-	defer shard.Unlock() // Release write lock after modification
+	shard, unlock := cm.lockShard(key)
+	defer unlock()
 
-	if _, exists := shard.items[key]; !exists {
+	if _, exists := shard.lookup(key); !exists {
 		return errors.New("key not found")
 	}
 
-	delete(shard.items, key) // Remove the key-value pair
-	// Safely decrement the size
-	cm.sizeMutex.Lock()
-	cm.size--
-	cm.sizeMutex.Unlock()
+	shard.remove(key)
 	return nil
 }
 
-// Size returns the total number of elements across all shards
+// Size returns the total number of elements across all shards.
+// It does not lock anything, so it may miss writes that run concurrently, see ConsistentSize
 func (cm *ConcurrentHashMap[K, V]) Size() int64 {
-	cm.sizeMutex.RLock()         // Acquire read lock for size
-	defer cm.sizeMutex.RUnlock() // Release lock after reading size
-	return cm.size
+	// Concurrent writes may be counted in any order, never report a transient negative count
+	return max(cm.size.sum(), 0)
+}
+
+// ConsistentSize returns the exact number of elements at a single point in time, briefly blocking
+// every writer and any resharding while it counts them
+func (cm *ConcurrentHashMap[K, V]) ConsistentSize() int64 {
+	// No split can be in progress while resizing is held, so none of the shards forwards anywhere
+	cm.resizing.Lock()
+	defer cm.resizing.Unlock()
+
+	table := cm.table.Load()
+	for _, shard := range table.shards {
+		shard.RLock()
+	}
+
+	var size int64
+	for _, shard := range table.shards {
+		size += int64(shard.items.len())
+	}
+
+	for _, shard := range table.shards {
+		shard.RUnlock()
+	}
+	return size
+}
+
+// IsEmpty reports whether the map has no key-value pairs
+func (cm *ConcurrentHashMap[K, V]) IsEmpty() bool {
+	return cm.Size() == 0
 }
 
 // Clear removes all elements from the map
 func (cm *ConcurrentHashMap[K, V]) Clear() {
-	cm.sizeMutex.Lock()         // Lock size for updating
-	defer cm.sizeMutex.Unlock() // Unlock after clearing
-
-	cm.size = 0 // Reset the total size
-	for i := 0; i < ShardCount; i++ {
-		shard := &cm.shards[i]
-		shard.Lock()                // Acquire write lock to block all reads/writes
-		shard.items = make(map[K]V) // Clear the shard's map
-		shard.Unlock()              // Release lock after clearing
+	table := cm.table.Load()
+	for i := range table.shards {
+		table.visitShard(i, true, func(shard *mapShard[K, V]) {
+			cm.size.add(uint64(i), -int64(shard.items.len()))
+			shard.items.clear()
+		})
 	}
 }
 
 // ContainsKey checks if a key exists in the map
 func (cm *ConcurrentHashMap[K, V]) ContainsKey(key K) bool {
-	shard := cm.getShard(key)
-	shard.RLock()         // Acquire read lock for safe access
-	defer shard.RUnlock() // Release read lock after check
-	_, exists := shard.items[key]
-	return exists
+	shard, hash, unlock := cm.readShard(key)
+	defer unlock()
+	return shard.items.find(hash, key) >= 0
+}
+
+// ContainsValue reports whether any key is associated with a value equal to value according to eq.
+// Shards are searched one at a time, so the answer may not reflect writes made while it runs.
+func (cm *ConcurrentHashMap[K, V]) ContainsValue(value V, eq func(a, b V) bool) bool {
+	return containsValue(cm.Values(), value, eq)
 }
 
 // NewIterator returns a new iterator for the concurrent map
 func (cm *ConcurrentHashMap[K, V]) NewIterator() collections.Iterator[Entry[K, V]] {
-	iterator := &ConcurrentHashMapIterator[K, V]{
-		cm:           cm,
-		currentShard: 0,
-		position:     -1,
+	return &ConcurrentHashMapIterator[K, V]{
+		table:    cm.table.Load(),
+		position: -1,
 	}
-	iterator.loadNextShard()
-	return iterator
+}
+
+// All returns an iter.Seq2 over the key-value pairs of the map.
+// Each shard is snapshotted under its read lock before being yielded, so the
+// loop body is free to modify the map; the order is unspecified.
+func (cm *ConcurrentHashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		table := cm.table.Load()
+		for i := range table.shards {
+			for _, entry := range table.snapshot(i) {
+				if !yield(entry.key, entry.value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Keys returns an iter.Seq over the keys of the map, in unspecified order
+func (cm *ConcurrentHashMap[K, V]) Keys() iter.Seq[K] {
+	return keysOf(cm.All())
+}
+
+// Values returns an iter.Seq over the values of the map, in unspecified order
+func (cm *ConcurrentHashMap[K, V]) Values() iter.Seq[V] {
+	return valuesOf(cm.All())
+}
+
+// Entries returns an iter.Seq over the key-value pairs of the map, in unspecified order
+func (cm *ConcurrentHashMap[K, V]) Entries() iter.Seq[Entry[K, V]] {
+	return entriesOf(cm.All())
 }
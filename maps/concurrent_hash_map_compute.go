@@ -0,0 +1,89 @@
+package maps
+
+// lockedShard exposes a shard to the compute family while its write lock is held,
+// keeping the size of the map up to date
+type lockedShard[K any, V any] struct {
+	cm    *ConcurrentHashMap[K, V]
+	shard *mapShard[K, V]
+	hash  uint64 // Hash of the key the shard was locked for, spreads the updates to the size of the map
+}
+
+// Ensure lockedShard implements computeOps
+var _ computeOps[string, int] = lockedShard[string, int]{}
+
+// PutIfAbsent atomically associates value with key unless the key is already present, returning the value
+// associated with key afterwards and whether it was already present
+func (cm *ConcurrentHashMap[K, V]) PutIfAbsent(key K, value V) (V, bool) {
+	shard, unlock := cm.lockShard(key)
+	defer unlock()
+	return putIfAbsent[K, V](shard, key, value)
+}
+
+// ComputeIfAbsent atomically associates the result of mapping with key unless the key is already present,
+// returning the value associated with key afterwards.
+// mapping runs under the lock of the shard holding key, so it must be short and must not use the map
+func (cm *ConcurrentHashMap[K, V]) ComputeIfAbsent(key K, mapping func(key K) V) V {
+	shard, unlock := cm.lockShard(key)
+	defer unlock()
+	return computeIfAbsent[K, V](shard, key, mapping)
+}
+
+// ComputeIfPresent atomically replaces the value associated with key, if any, with the result of remapping,
+// removing the key when remapping returns false.
+// remapping runs under the lock of the shard holding key, so it must be short and must not use the map
+func (cm *ConcurrentHashMap[K, V]) ComputeIfPresent(key K, remapping func(key K, value V) (V, bool)) (V, bool) {
+	shard, unlock := cm.lockShard(key)
+	defer unlock()
+	return computeIfPresent[K, V](shard, key, remapping)
+}
+
+// Compute atomically replaces the value associated with key with the result of remapping, which also learns
+// whether the key was present, removing the key when remapping returns false.
+// remapping runs under the lock of the shard holding key, so it must be short and must not use the map
+func (cm *ConcurrentHashMap[K, V]) Compute(key K, remapping func(key K, value V, present bool) (V, bool)) (V, bool) {
+	shard, unlock := cm.lockShard(key)
+	defer unlock()
+	return compute[K, V](shard, key, remapping)
+}
+
+// Merge atomically associates value with key if the key is absent, otherwise combines both values with merging,
+// removing the key when merging returns false.
+// merging runs under the lock of the shard holding key, so it must be short and must not use the map
+func (cm *ConcurrentHashMap[K, V]) Merge(key K, value V, merging func(old, value V) (V, bool)) (V, bool) {
+	shard, unlock := cm.lockShard(key)
+	defer unlock()
+	return merge[K, V](shard, key, value, merging)
+}
+
+// Replace atomically associates newValue with key only if it is currently associated with a value equal to oldValue according to eq
+func (cm *ConcurrentHashMap[K, V]) Replace(key K, oldValue, newValue V, eq func(a, b V) bool) bool {
+	shard, unlock := cm.lockShard(key)
+	defer unlock()
+	return replace[K, V](shard, key, oldValue, newValue, eq)
+}
+
+// RemoveIf atomically removes key only if it is currently associated with a value equal to expected according to eq
+func (cm *ConcurrentHashMap[K, V]) RemoveIf(key K, expected V, eq func(a, b V) bool) bool {
+	shard, unlock := cm.lockShard(key)
+	defer unlock()
+	return removeIfEqual[K, V](shard, key, expected, eq)
+}
+
+// lookup returns the value associated with key and whether the key is present
+func (s lockedShard[K, V]) lookup(key K) (V, bool) {
+	return s.shard.items.get(s.hash, key)
+}
+
+// store inserts or updates a key-value pair, which the map always accepts
+func (s lockedShard[K, V]) store(key K, value V) bool {
+	if s.shard.items.put(s.hash, key, value) {
+		s.cm.size.add(s.hash, 1)
+	}
+	return true
+}
+
+// remove removes key, which must be present
+func (s lockedShard[K, V]) remove(key K) {
+	s.shard.items.remove(s.hash, key)
+	s.cm.size.add(s.hash, -1)
+}
@@ -1,62 +1,50 @@
 package maps
 
 import (
-	"errors"
+	"github.com/jorge-barroso/collections"
 )
 
 // ConcurrentHashMapIterator implements iterator for ConcurrentHashMap
-type ConcurrentHashMapIterator[K comparable, V any] struct {
-	cm           *ConcurrentHashMap[K, V]
+type ConcurrentHashMapIterator[K any, V any] struct {
+	table        *shardTable[K, V] // Shards of the map when the iterator was created
 	currentShard int
 	entries      []Entry[K, V]
 	position     int
+	current      *Entry[K, V] // Entry the iterator is positioned on
 }
 
-// loadNextShard loads entries from the next available shard
-func (it *ConcurrentHashMapIterator[K, V]) loadNextShard() {
-	for it.currentShard < ShardCount {
-		shard := &it.cm.shards[it.currentShard]
-		shard.RLock()
-
-		entries := make([]Entry[K, V], 0, len(shard.items))
-		for k, v := range shard.items {
-			entries = append(entries, Entry[K, V]{key: k, value: v})
-		}
-		shard.RUnlock()
-
+// loadNextShard loads entries from the next non-empty shard, reporting whether one was found
+func (it *ConcurrentHashMapIterator[K, V]) loadNextShard() bool {
+	for it.currentShard < len(it.table.shards) {
+		entries := it.table.snapshot(it.currentShard)
 		it.currentShard++
 		if len(entries) > 0 {
 			it.entries = entries
 			it.position = -1
-			return
+			return true
 		}
 	}
-	it.entries = nil
-	it.position = -1
+	return false
 }
 
-// Next checks if there are more elements
+// Next advances the iterator to the next entry, moving on to the following shards as needed
 func (it *ConcurrentHashMapIterator[K, V]) Next() bool {
-	if it.entries == nil {
-		it.loadNextShard()
-		if it.entries == nil {
+	for it.position+1 >= len(it.entries) {
+		if !it.loadNextShard() {
 			return false
 		}
 	}
 
 	it.position++
-	if it.position >= len(it.entries) {
-		it.loadNextShard()
-		return it.Next()
-	}
+	it.current = &it.entries[it.position]
 	return true
 }
 
 // Value returns the current key-value pair
 func (it *ConcurrentHashMapIterator[K, V]) Value() (Entry[K, V], error) {
-	if it.entries == nil || it.position >= len(it.entries) {
+	if it.current == nil {
 		var zero Entry[K, V]
-		return zero, errors.New("no more elements")
+		return zero, collections.ErrNoSuchElement
 	}
-	return it.entries[it.position], nil
+	return *it.current, nil
 }
@@ -0,0 +1,92 @@
+package maps
+
+import (
+	"math/bits"
+
+	"github.com/jorge-barroso/collections/hashing"
+)
+
+const (
+	// DefaultShardCount is the number of segments a ConcurrentHashMap starts with unless told otherwise
+	DefaultShardCount = 16
+	// DefaultMaxShardCount is the number of segments past which a ConcurrentHashMap stops splitting its shards
+	DefaultMaxShardCount = 1024
+	// DefaultShardLoadThreshold is the number of entries a shard may hold before the shards are split
+	DefaultShardLoadThreshold = 8192
+)
+
+// concurrentHashMapConfig holds the settings ConcurrentHashMapOption values apply to
+type concurrentHashMapConfig struct {
+	shardCount    int
+	maxShardCount int
+	loadThreshold int
+	randomSeed    bool
+}
+
+// ConcurrentHashMapOption configures a ConcurrentHashMap when it is created
+type ConcurrentHashMapOption func(*concurrentHashMapConfig)
+
+// WithShardCount sets the number of shards the map starts with, rounded up to a power of two
+func WithShardCount(count int) ConcurrentHashMapOption {
+	return func(config *concurrentHashMapConfig) {
+		config.shardCount = count
+	}
+}
+
+// WithMaxShardCount caps the number of shards the map can split into, rounded up to a power of two.
+// Setting it to the initial shard count disables resharding
+func WithMaxShardCount(count int) ConcurrentHashMapOption {
+	return func(config *concurrentHashMapConfig) {
+		config.maxShardCount = count
+	}
+}
+
+// WithShardLoadThreshold sets the number of entries a shard may hold before the shards are split
+func WithShardLoadThreshold(entries int) ConcurrentHashMapOption {
+	return func(config *concurrentHashMapConfig) {
+		config.loadThreshold = entries
+	}
+}
+
+// WithRandomSeed keys the hash function of the map with a random seed chosen when the map is created,
+// so that keys coming from untrusted input cannot be picked to collide on purpose.
+// Hash functions that cannot be seeded are replaced with a randomly keyed SipHash.
+func WithRandomSeed() ConcurrentHashMapOption {
+	return func(config *concurrentHashMapConfig) {
+		config.randomSeed = true
+	}
+}
+
+// newConcurrentHashMapConfig applies the options over the defaults and normalises the result
+func newConcurrentHashMapConfig(options []ConcurrentHashMapOption) concurrentHashMapConfig {
+	config := concurrentHashMapConfig{
+		shardCount:    DefaultShardCount,
+		maxShardCount: DefaultMaxShardCount,
+		loadThreshold: DefaultShardLoadThreshold,
+	}
+	for _, option := range options {
+		option(&config)
+	}
+
+	config.shardCount = nextPowerOfTwo(config.shardCount)
+	config.maxShardCount = max(nextPowerOfTwo(config.maxShardCount), config.shardCount)
+	config.loadThreshold = max(config.loadThreshold, 1)
+	return config
+}
+
+// nextPowerOfTwo returns the smallest power of two greater than or equal to n, and at least 1
+func nextPowerOfTwo(n int) int {
+	if n <= 1 {
+		return 1
+	}
+	return 1 << bits.Len(uint(n-1))
+}
+
+// randomlySeeded returns a copy of hashFunc keyed with a fresh random seed, or a randomly keyed SipHash
+// if hashFunc cannot be seeded
+func randomlySeeded[K comparable](hashFunc hashing.HashFunction[K]) hashing.HashFunction[K] {
+	if seeded, ok := hashFunc.(hashing.SeededHashFunction[K]); ok {
+		return seeded.Reseed()
+	}
+	return hashing.NewSipHash[K]()
+}
@@ -0,0 +1,157 @@
+package maps
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/jorge-barroso/collections/hashing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentHashMap_ShardCountOptions(t *testing.T) {
+	assert.Equal(t, DefaultShardCount, NewConcurrentHashMap[int, int]().ShardCount(), "Unexpected default shard count")
+	assert.Equal(t, 1, NewConcurrentHashMap[int, int](WithShardCount(0)).ShardCount(), "Shard count should be at least 1")
+	assert.Equal(t, 64, NewConcurrentHashMap[int, int](WithShardCount(64)).ShardCount(), "Powers of two should be kept as is")
+	assert.Equal(t, 128, NewConcurrentHashMap[int, int](WithShardCount(65)).ShardCount(), "Shard count should be rounded up to a power of two")
+
+	config := newConcurrentHashMapConfig([]ConcurrentHashMapOption{WithShardCount(32), WithMaxShardCount(8)})
+	assert.Equal(t, 32, config.maxShardCount, "The maximum shard count should never be below the initial one")
+}
+
+func TestConcurrentHashMap_SplitsOnLoad(t *testing.T) {
+	cm := NewConcurrentHashMap[int, int](WithShardCount(1), WithMaxShardCount(16), WithShardLoadThreshold(8))
+	for i := 0; i < 1000; i++ {
+		cm.Put(i, i*10)
+	}
+
+	assert.Equal(t, 16, cm.ShardCount(), "The shards should have split up to the maximum")
+	assert.Equal(t, int64(1000), cm.Size(), "Size mismatch after splitting")
+	for i := 0; i < 1000; i++ {
+		value, err := cm.Get(i)
+		assert.NoError(t, err, "Key %d was lost while splitting", i)
+		assert.Equal(t, i*10, value, "Value mismatch for key %d after splitting", i)
+	}
+
+	cm.Clear()
+	assert.True(t, cm.IsEmpty(), "Expected an empty map after Clear()")
+	assert.Empty(t, slices.Collect(cm.Keys()), "Expected no keys after Clear()")
+}
+
+func TestConcurrentHashMap_MaxShardCountDisablesSplitting(t *testing.T) {
+	cm := NewConcurrentHashMap[int, int](WithShardCount(4), WithMaxShardCount(4), WithShardLoadThreshold(1))
+	for i := 0; i < 100; i++ {
+		cm.Put(i, i)
+	}
+	assert.Equal(t, 4, cm.ShardCount(), "The shards should not split past the maximum")
+}
+
+func TestConcurrentHashMap_IteratorAcrossSplits(t *testing.T) {
+	cm := NewConcurrentHashMap[int, int](WithShardCount(2), WithShardLoadThreshold(16))
+	for i := 0; i < 20; i++ {
+		cm.Put(i, i)
+	}
+
+	// Iterators keep the shards they were created with, which forward to the split ones
+	iter := cm.NewIterator()
+	spliterator := cm.Spliterator()
+	for i := 20; i < 500; i++ {
+		cm.Put(i, i)
+	}
+	assert.Greater(t, cm.ShardCount(), 2, "Expected the shards to split")
+
+	var keys []int
+	for iter.Next() {
+		entry, err := iter.Value()
+		assert.NoError(t, err, "Unexpected error during iteration")
+		keys = append(keys, entry.Key())
+	}
+	slices.Sort(keys)
+	assert.Equal(t, 500, len(keys), "The iterator should see every key once, even across splits")
+	assert.Equal(t, 499, keys[len(keys)-1], "The iterator should see the keys moved into split shards")
+
+	count := 0
+	spliterator.ForEachRemaining(func(Entry[int, int]) { count++ })
+	assert.Equal(t, 500, count, "The spliterator should see every key once, even across splits")
+}
+
+func TestConcurrentHashMap_ConcurrentSplits(t *testing.T) {
+	cm := NewConcurrentHashMap[string, int](WithShardCount(1), WithShardLoadThreshold(32))
+	const goroutines, keysPerGoroutine = 8, 2000
+	var wg sync.WaitGroup
+
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < keysPerGoroutine; i++ {
+				key := fmt.Sprintf("%d-%d", g, i)
+				cm.Put(key, i)
+				cm.Merge(key, 1, func(old, value int) (int, bool) { return old + value, true })
+				if i%4 == 0 {
+					assert.NoError(t, cm.Remove(key), "Key %s was lost while splitting", key)
+				}
+				_, _ = cm.Get(fmt.Sprintf("%d-%d", (g+1)%goroutines, i))
+			}
+		}()
+	}
+
+	// Readers ranging over the map while it splits
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 20; i++ {
+			for range cm.All() {
+			}
+		}
+	}()
+	wg.Wait()
+
+	assert.Greater(t, cm.ShardCount(), 1, "Expected the shards to split")
+	assert.Equal(t, int64(goroutines*keysPerGoroutine*3/4), cm.Size(), "Size mismatch after concurrent splits")
+	for g := 0; g < goroutines; g++ {
+		for i := 1; i < keysPerGoroutine; i += 4 {
+			key := fmt.Sprintf("%d-%d", g, i)
+			value, err := cm.Get(key)
+			assert.NoError(t, err, "Key %s was lost while splitting", key)
+			assert.Equal(t, i+1, value, "Value mismatch for key %s", key)
+		}
+	}
+}
+
+func TestMapShard_RecordLock(t *testing.T) {
+	shard := newMapShard[int, int](hashing.NewComparableHasher[int](hashing.NewFNVHash[int]()))
+	overloaded := false
+	for i := 0; i < contentionWindow; i++ {
+		overloaded = shard.recordLock(i < contentionThreshold-1)
+		assert.False(t, overloaded && i < contentionWindow-1, "Contention should only be judged at the end of a window")
+	}
+	assert.False(t, overloaded, "A window just below the threshold should not be overloaded")
+
+	for i := 0; i < contentionWindow; i++ {
+		overloaded = shard.recordLock(i < contentionThreshold)
+	}
+	assert.True(t, overloaded, "A window reaching the threshold should be overloaded")
+}
+
+func TestConcurrentHashMap_WithRandomSeed(t *testing.T) {
+	fixed := hashing.NewSipHashWithKey[string](1, 2)
+	cm := NewConcurrentHashMapWithHash[string, int](fixed, WithRandomSeed())
+	assert.NotEqual(t, fixed.Hash("key"), cm.hasher.Hash("key"), "A seeded hash function should be replaced by a reseeded copy")
+
+	unseeded := NewConcurrentHashMap[string, int](WithRandomSeed())
+	assert.NotEqual(t, hashing.NewFNVHash[string]().Hash("key"), unseeded.hasher.Hash("key"), "Unseeded hash functions should be replaced by SipHash")
+
+	kept := NewConcurrentHashMapWithHash[string, int](fixed)
+	assert.Equal(t, fixed.Hash("key"), kept.hasher.Hash("key"), "Without the option the hash function should be used as is")
+
+	for i := 0; i < 100; i++ {
+		cm.Put(fmt.Sprint(i), i)
+	}
+	for i := 0; i < 100; i++ {
+		value, err := cm.Get(fmt.Sprint(i))
+		assert.NoError(t, err, "Key %d should be found", i)
+		assert.Equal(t, i, value, "Unexpected value for key %d", i)
+	}
+}
@@ -0,0 +1,106 @@
+package maps
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/jorge-barroso/collections/hashing"
+)
+
+const (
+	// contentionWindow is the number of write locks over which the contention of a shard is measured
+	contentionWindow = 1024
+	// contentionThreshold is the number of contended write locks within a window that makes the shards split
+	contentionThreshold = contentionWindow / 8
+)
+
+// mapShard represents a single shard of the concurrent map
+type mapShard[K any, V any] struct {
+	items *hashTable[K, V]
+	sync.RWMutex
+	forward   *shardTable[K, V] // Table the entries were migrated to, nil while the shard is in use
+	locks     int               // Write locks taken in the current contention window
+	contended int               // Write locks in the current window that had to wait for another goroutine
+}
+
+// newMapShard creates an empty shard comparing keys with hasher
+func newMapShard[K any, V any](hasher hashing.Hasher[K]) *mapShard[K, V] {
+	return &mapShard[K, V]{
+		items: newHashTable[K, V](hasher),
+	}
+}
+
+// recordLock accounts for a write lock of the shard, which must be held, and reports whether the
+// shard has been contended often enough during the last window to be worth splitting
+func (s *mapShard[K, V]) recordLock(contended bool) bool {
+	s.locks++
+	if contended {
+		s.contended++
+	}
+	if s.locks < contentionWindow {
+		return false
+	}
+
+	overloaded := s.contended >= contentionThreshold
+	s.locks, s.contended = 0, 0
+	return overloaded
+}
+
+// shardTable is an immutable array of shards, a key lives in the shard selected by the low bits of its hash
+type shardTable[K any, V any] struct {
+	shards []*mapShard[K, V]
+	mask   uint64
+}
+
+// newShardTable creates a table of count empty shards comparing keys with hasher, count must be a power of two
+func newShardTable[K any, V any](count int, hasher hashing.Hasher[K]) *shardTable[K, V] {
+	table := &shardTable[K, V]{
+		shards: make([]*mapShard[K, V], count),
+		mask:   uint64(count - 1),
+	}
+	for i := range table.shards {
+		table.shards[i] = newMapShard[K, V](hasher)
+	}
+	return table
+}
+
+// shardFor returns the shard of the table a hash maps to
+func (t *shardTable[K, V]) shardFor(hash uint64) *mapShard[K, V] {
+	return t.shards[hash&t.mask]
+}
+
+// visitShard calls visit with shard i of the table locked, exclusively or for reading.
+// If the shard has been migrated, visit is called in turn on each of the shards it was split into.
+func (t *shardTable[K, V]) visitShard(i int, exclusive bool, visit func(*mapShard[K, V])) {
+	shard := t.shards[i]
+	lock, unlock := shard.RLock, shard.RUnlock
+	if exclusive {
+		lock, unlock = shard.Lock, shard.Unlock
+	}
+
+	lock()
+	forward := shard.forward
+	if forward == nil {
+		defer unlock()
+		visit(shard)
+		return
+	}
+	unlock()
+
+	// Shard i of a table with n shards splits into shards i, i+n, i+2n... of the larger one
+	for j := i; j < len(forward.shards); j += len(t.shards) {
+		forward.visitShard(j, exclusive, visit)
+	}
+}
+
+// snapshot copies the entries of shard i of the table, or of the shards it was split into
+func (t *shardTable[K, V]) snapshot(i int) []Entry[K, V] {
+	var entries []Entry[K, V]
+	t.visitShard(i, false, func(shard *mapShard[K, V]) {
+		entries = slices.Grow(entries, shard.items.len())
+		for _, entry := range shard.items.entries {
+			entries = append(entries, entry.Entry)
+		}
+	})
+	return entries
+}
@@ -0,0 +1,79 @@
+package maps
+
+import "github.com/jorge-barroso/collections"
+
+// concurrentHashMapSpliterator traverses the shards [shard, fence) of a ConcurrentHashMap.
+// Each shard is snapshotted under its read lock when the traversal reaches it, so the map
+// can keep being modified while the spliterator is in use.
+type concurrentHashMapSpliterator[K any, V any] struct {
+	cm       *ConcurrentHashMap[K, V]
+	table    *shardTable[K, V] // Shards of the map when the traversal started
+	shard    int               // Next shard to load
+	fence    int               // One past the last shard of the range
+	entries  []Entry[K, V]     // Snapshot of the shard being traversed
+	position int               // Next entry to visit in entries
+}
+
+// Spliterator returns a splittable traversal over the entries of the map that splits along shards
+func (cm *ConcurrentHashMap[K, V]) Spliterator() collections.Spliterator[Entry[K, V]] {
+	table := cm.table.Load()
+	return &concurrentHashMapSpliterator[K, V]{
+		cm:    cm,
+		table: table,
+		fence: len(table.shards),
+	}
+}
+
+// TryAdvance calls action on the next entry, loading the following shards as needed
+func (s *concurrentHashMapSpliterator[K, V]) TryAdvance(action func(Entry[K, V])) bool {
+	for s.position >= len(s.entries) {
+		if s.shard >= s.fence {
+			return false
+		}
+		s.loadShard()
+	}
+
+	action(s.entries[s.position])
+	s.position++
+	return true
+}
+
+// ForEachRemaining calls action on every entry left in the range
+func (s *concurrentHashMapSpliterator[K, V]) ForEachRemaining(action func(Entry[K, V])) {
+	for s.TryAdvance(action) {
+	}
+}
+
+// TrySplit hands the first half of the remaining shards over to a new spliterator,
+// along with whatever is left of the shard currently being traversed
+func (s *concurrentHashMapSpliterator[K, V]) TrySplit() collections.Spliterator[Entry[K, V]] {
+	mid := s.shard + (s.fence-s.shard)/2
+	if mid <= s.shard {
+		return nil
+	}
+
+	prefix := &concurrentHashMapSpliterator[K, V]{
+		cm:      s.cm,
+		table:   s.table,
+		shard:   s.shard,
+		fence:   mid,
+		entries: s.entries[s.position:],
+	}
+	s.shard = mid
+	s.entries = nil
+	s.position = 0
+	return prefix
+}
+
+// EstimateSize assumes the remaining shards hold an even share of the map
+func (s *concurrentHashMapSpliterator[K, V]) EstimateSize() int {
+	perShard := int(s.cm.Size()) / len(s.table.shards)
+	return len(s.entries) - s.position + (s.fence-s.shard)*perShard
+}
+
+// loadShard snapshots the next shard of the range
+func (s *concurrentHashMapSpliterator[K, V]) loadShard() {
+	s.entries = s.table.snapshot(s.shard)
+	s.position = 0
+	s.shard++
+}
@@ -0,0 +1,19 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/jorge-barroso/collections/collectionstest"
+)
+
+func TestConcurrentHashMap_Spliterator(t *testing.T) {
+	for _, size := range []int{0, 1, 100} {
+		cm := NewConcurrentHashMap[int, int]()
+		var want []Entry[int, int]
+		for i := 0; i < size; i++ {
+			cm.Put(i, -i)
+			want = append(want, Entry[int, int]{key: i, value: -i})
+		}
+		collectionstest.TestSpliterator(t, cm.Spliterator, want, false)
+	}
+}
@@ -2,9 +2,13 @@ package maps
 
 import (
 	"fmt"
+	stdmaps "maps"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"testing"
 
+	"github.com/jorge-barroso/collections/collectionstest"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -163,3 +167,129 @@ func TestConcurrentHashMap_ConcurrentReadsAndWrites(t *testing.T) {
 	expectedSize := int64(1000)
 	assert.Equal(t, expectedSize, cm.Size(), "Size mismatch after concurrent reads and writes")
 }
+
+func TestConcurrentHashMap_All(t *testing.T) {
+	cm := NewConcurrentHashMap[string, int]()
+	testData := map[string]int{"one": 1, "two": 2, "three": 3}
+	for k, v := range testData {
+		cm.Put(k, v)
+	}
+
+	assert.Equal(t, testData, stdmaps.Collect(cm.All()), "All() should yield every key-value pair")
+	assert.ElementsMatch(t, []string{"one", "two", "three"}, slices.Collect(cm.Keys()), "Keys() mismatch")
+	assert.ElementsMatch(t, []int{1, 2, 3}, slices.Collect(cm.Values()), "Values() mismatch")
+
+	// The loop body may write to the map without deadlocking
+	for k, v := range cm.All() {
+		cm.Put(k, v*10)
+	}
+	value, err := cm.Get("two")
+	assert.NoError(t, err, "Unexpected error when getting key 'two'")
+	assert.Equal(t, 20, value, "Value mismatch after updating during iteration")
+}
+
+func TestConcurrentHashMap_IteratorConformance(t *testing.T) {
+	cm := NewConcurrentHashMap[string, int]()
+	collectionstest.TestUnorderedIterable[Entry[string, int]](t, cm, nil)
+
+	cm.Put("one", 1)
+	cm.Put("two", 2)
+	cm.Put("three", 3)
+	collectionstest.TestUnorderedIterable[Entry[string, int]](t, cm, []Entry[string, int]{
+		{key: "one", value: 1},
+		{key: "two", value: 2},
+		{key: "three", value: 3},
+	})
+}
+
+func TestConcurrentHashMap_AtomicCompute(t *testing.T) {
+	cm := NewConcurrentHashMap[string, int]()
+	const goroutines, increments = 8, 1000
+	var computed atomic.Int32
+	var wg sync.WaitGroup
+
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < increments; j++ {
+				cm.Merge("counter", 1, func(old, value int) (int, bool) { return old + value, true })
+				cm.ComputeIfAbsent("once", func(key string) int {
+					computed.Add(1)
+					return 42
+				})
+				cm.Compute("toggle", func(key string, value int, present bool) (int, bool) { return 1, !present })
+			}
+		}()
+	}
+	wg.Wait()
+
+	counter, err := cm.Get("counter")
+	assert.NoError(t, err, "Unexpected error when getting the counter")
+	assert.Equal(t, goroutines*increments, counter, "Merge() lost updates under contention")
+	assert.Equal(t, int32(1), computed.Load(), "ComputeIfAbsent() should compute the value only once")
+	assert.False(t, cm.ContainsKey("toggle"), "Compute() should have toggled the key an even number of times")
+	assert.Equal(t, int64(2), cm.Size(), "Size mismatch after concurrent computations")
+}
+
+func TestConcurrentHashMap_ConsistentSize(t *testing.T) {
+	cm := NewConcurrentHashMap[int, int](WithShardCount(4), WithShardLoadThreshold(64))
+	assert.Equal(t, int64(0), cm.ConsistentSize(), "Expected an empty map")
+
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				cm.Put(g*1000+i, i)
+				if i%2 == 0 {
+					_ = cm.Remove(g*1000 + i)
+				}
+			}
+		}()
+	}
+
+	// Sizes observed while writers run must stay within bounds
+	for i := 0; i < 100; i++ {
+		size := cm.ConsistentSize()
+		assert.True(t, size >= 0 && size <= 4000, "ConsistentSize() out of bounds: %d", size)
+		assert.GreaterOrEqual(t, cm.Size(), int64(0), "Size() should never be negative")
+	}
+	wg.Wait()
+
+	assert.Equal(t, int64(2000), cm.ConsistentSize(), "ConsistentSize() mismatch once writers are done")
+	assert.Equal(t, cm.ConsistentSize(), cm.Size(), "Size() should be exact once writers are done")
+
+	cm.Clear()
+	assert.Equal(t, int64(0), cm.Size(), "Expected size 0 after Clear()")
+	assert.Equal(t, int64(0), cm.ConsistentSize(), "Expected a consistent size of 0 after Clear()")
+}
+
+func TestConcurrentHashMap_WithHasher(t *testing.T) {
+	cm := NewConcurrentHashMapWithHasher[[]int, int](sliceHasher, WithShardCount(2), WithShardLoadThreshold(4))
+
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				cm.Merge([]int{i % 50, i % 7}, 1, func(old, value int) (int, bool) { return old + value, true })
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Greater(t, cm.ShardCount(), 2, "The shards should have split with slice keys too")
+	assert.Equal(t, cm.ConsistentSize(), cm.Size(), "Size should match the number of entries")
+	total := 0
+	for key, count := range cm.All() {
+		total += count
+		assert.Equal(t, count, cm.GetOrDefault(slices.Clone(key), 0), "Equal slices should find the same entry")
+	}
+	assert.Equal(t, 400, total, "Every merge should have been counted once")
+
+	assert.NoError(t, cm.Remove([]int{0, 0}), "Unexpected error when removing an equal slice")
+	assert.False(t, cm.ContainsKey([]int{0, 0}), "Removed keys should no longer be found")
+}
@@ -1,6 +1,6 @@
 package maps
 
-type Entry[K comparable, V any] struct {
+type Entry[K any, V any] struct {
 	key   K
 	value V
 }
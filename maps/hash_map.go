@@ -0,0 +1,132 @@
+package maps
+
+import (
+	"errors"
+	"iter"
+
+	"github.com/jorge-barroso/collections"
+	"github.com/jorge-barroso/collections/hashing"
+)
+
+// HashMap implements both Map and collections.Iterable interfaces for keys hashed and compared by a
+// hashing.Hasher, so they can be slices, structs holding slices or values with a custom equality.
+// Entries are kept in no particular order, removing one moves the last entry into its place.
+type HashMap[K any, V any] struct {
+	table    *hashTable[K, V]
+	modCount int // Number of structural modifications, used by iterators to fail fast
+}
+
+// Ensure HashMap implements both Map and Iterable interfaces
+var _ Map[[]string, int] = (*HashMap[[]string, int])(nil)
+var _ collections.Iterable[Entry[[]string, int]] = (*HashMap[[]string, int])(nil)
+
+// NewHashMap creates a new HashMap whose keys are hashed and compared by hasher
+func NewHashMap[K any, V any](hasher hashing.Hasher[K]) *HashMap[K, V] {
+	return &HashMap[K, V]{
+		table: newHashTable[K, V](hasher),
+	}
+}
+
+// Put inserts or updates a key-value pair
+func (m *HashMap[K, V]) Put(key K, value V) {
+	m.store(key, value)
+}
+
+// Get retrieves the value associated with a key
+func (m *HashMap[K, V]) Get(key K) (V, error) {
+	if value, ok := m.lookup(key); ok {
+		return value, nil
+	}
+	var zero V
+	return zero, errors.New("key not found")
+}
+
+// GetOrDefault retrieves the value associated with a key, or defaultValue if the key is not in the map
+func (m *HashMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	if value, ok := m.lookup(key); ok {
+		return value
+	}
+	return defaultValue
+}
+
+// PutAll inserts or updates every key-value pair of other
+func (m *HashMap[K, V]) PutAll(other Map[K, V]) {
+	for key, value := range other.All() {
+		m.Put(key, value)
+	}
+}
+
+// ContainsKey reports whether the key is in the map
+func (m *HashMap[K, V]) ContainsKey(key K) bool {
+	return m.table.find(m.table.hasher.Hash(key), key) >= 0
+}
+
+// ContainsValue reports whether any key is associated with a value equal to value according to eq
+func (m *HashMap[K, V]) ContainsValue(value V, eq func(a, b V) bool) bool {
+	return containsValue(m.Values(), value, eq)
+}
+
+// Remove removes a key-value pair
+func (m *HashMap[K, V]) Remove(key K) error {
+	if !m.table.remove(m.table.hasher.Hash(key), key) {
+		return errors.New("key not found")
+	}
+	m.modCount++
+	return nil
+}
+
+// Size returns the number of key-value pairs
+func (m *HashMap[K, V]) Size() int64 {
+	return int64(m.table.len())
+}
+
+// IsEmpty reports whether the map has no key-value pairs
+func (m *HashMap[K, V]) IsEmpty() bool {
+	return m.table.len() == 0
+}
+
+// Clear removes every key-value pair
+func (m *HashMap[K, V]) Clear() {
+	m.table.clear()
+	m.modCount++
+}
+
+// NewIterator returns a new iterator for the HashMap
+func (m *HashMap[K, V]) NewIterator() collections.Iterator[Entry[K, V]] {
+	return m.NewMutableIterator()
+}
+
+// NewMutableIterator returns a new iterator that can also remove entries
+func (m *HashMap[K, V]) NewMutableIterator() collections.MutableIterator[Entry[K, V]] {
+	return &HashMapIterator[K, V]{
+		m:                m,
+		position:         -1,
+		expectedModCount: m.modCount,
+	}
+}
+
+// All returns an iter.Seq2 over the key-value pairs in unspecified order
+func (m *HashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, entry := range m.table.entries {
+			if !yield(entry.key, entry.value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iter.Seq over the keys in unspecified order
+func (m *HashMap[K, V]) Keys() iter.Seq[K] {
+	return keysOf(m.All())
+}
+
+// Values returns an iter.Seq over the values in unspecified order
+func (m *HashMap[K, V]) Values() iter.Seq[V] {
+	return valuesOf(m.All())
+}
+
+// Entries returns an iter.Seq over the key-value pairs in unspecified order
+func (m *HashMap[K, V]) Entries() iter.Seq[Entry[K, V]] {
+	return entriesOf(m.All())
+}
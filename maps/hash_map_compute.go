@@ -0,0 +1,63 @@
+package maps
+
+// Ensure HashMap implements computeOps
+var _ computeOps[string, int] = (*HashMap[string, int])(nil)
+
+// PutIfAbsent associates value with key unless the key is already present, returning the value
+// associated with key afterwards and whether it was already present
+func (m *HashMap[K, V]) PutIfAbsent(key K, value V) (V, bool) {
+	return putIfAbsent[K, V](m, key, value)
+}
+
+// ComputeIfAbsent associates the result of mapping with key unless the key is already present,
+// returning the value associated with key afterwards. mapping must not modify the map
+func (m *HashMap[K, V]) ComputeIfAbsent(key K, mapping func(key K) V) V {
+	return computeIfAbsent[K, V](m, key, mapping)
+}
+
+// ComputeIfPresent replaces the value associated with key, if any, with the result of remapping,
+// removing the key when remapping returns false. remapping must not modify the map
+func (m *HashMap[K, V]) ComputeIfPresent(key K, remapping func(key K, value V) (V, bool)) (V, bool) {
+	return computeIfPresent[K, V](m, key, remapping)
+}
+
+// Compute replaces the value associated with key with the result of remapping, which also learns whether
+// the key was present, removing the key when remapping returns false. remapping must not modify the map
+func (m *HashMap[K, V]) Compute(key K, remapping func(key K, value V, present bool) (V, bool)) (V, bool) {
+	return compute[K, V](m, key, remapping)
+}
+
+// Merge associates value with key if the key is absent, otherwise combines both values with merging,
+// removing the key when merging returns false. merging must not modify the map
+func (m *HashMap[K, V]) Merge(key K, value V, merging func(old, value V) (V, bool)) (V, bool) {
+	return merge[K, V](m, key, value, merging)
+}
+
+// Replace associates newValue with key only if it is currently associated with a value equal to oldValue according to eq
+func (m *HashMap[K, V]) Replace(key K, oldValue, newValue V, eq func(a, b V) bool) bool {
+	return replace[K, V](m, key, oldValue, newValue, eq)
+}
+
+// RemoveIf removes key only if it is currently associated with a value equal to expected according to eq
+func (m *HashMap[K, V]) RemoveIf(key K, expected V, eq func(a, b V) bool) bool {
+	return removeIfEqual[K, V](m, key, expected, eq)
+}
+
+// lookup returns the value associated with key and whether the key is present
+func (m *HashMap[K, V]) lookup(key K) (V, bool) {
+	return m.table.get(m.table.hasher.Hash(key), key)
+}
+
+// store inserts or updates a key-value pair, which the map always accepts
+func (m *HashMap[K, V]) store(key K, value V) bool {
+	if m.table.put(m.table.hasher.Hash(key), key, value) {
+		m.modCount++
+	}
+	return true
+}
+
+// remove removes key, which must be present
+func (m *HashMap[K, V]) remove(key K) {
+	m.table.remove(m.table.hasher.Hash(key), key)
+	m.modCount++
+}
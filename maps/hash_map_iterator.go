@@ -0,0 +1,75 @@
+package maps
+
+import (
+	"github.com/jorge-barroso/collections"
+)
+
+// HashMapIterator implements the MutableIterator interface for HashMap
+type HashMapIterator[K any, V any] struct {
+	m                *HashMap[K, V]
+	position         int  // Position of the entry the iterator is positioned on, or of the last one visited
+	positioned       bool // Whether position holds the entry the iterator is positioned on
+	expectedModCount int  // modCount of the map when the iterator last synchronised with it
+	failed           bool // Set once a concurrent modification has been reported by Next
+}
+
+// Ensure HashMapIterator implements MutableIterator
+var _ collections.MutableIterator[Entry[string, int]] = (*HashMapIterator[string, int])(nil)
+
+// Next advances the iterator to the next entry
+func (it *HashMapIterator[K, V]) Next() bool {
+	if it.modified() {
+		if it.failed {
+			return false
+		}
+		it.failed = true
+		return true
+	}
+
+	if it.position+1 >= it.m.table.len() {
+		return false
+	}
+	it.position++
+	it.positioned = true
+	return true
+}
+
+// Value returns the element the iterator is positioned on
+func (it *HashMapIterator[K, V]) Value() (Entry[K, V], error) {
+	if err := it.checkPositioned(); err != nil {
+		var zero Entry[K, V]
+		return zero, err
+	}
+	return it.m.table.entries[it.position].Entry, nil
+}
+
+// Remove deletes the entry the iterator is positioned on in constant time
+func (it *HashMapIterator[K, V]) Remove() error {
+	if err := it.checkPositioned(); err != nil {
+		return err
+	}
+
+	// The last entry moves into the position being removed, so it is visited next
+	it.m.table.removeAt(it.position)
+	it.m.modCount++
+	it.position--
+	it.positioned = false
+	it.expectedModCount = it.m.modCount
+	return nil
+}
+
+// checkPositioned returns an error unless the iterator sits on a valid, unmodified entry
+func (it *HashMapIterator[K, V]) checkPositioned() error {
+	if it.modified() {
+		return collections.ErrConcurrentModification
+	}
+	if !it.positioned {
+		return collections.ErrNoSuchElement
+	}
+	return nil
+}
+
+// modified reports whether the map has been structurally modified behind the iterator
+func (it *HashMapIterator[K, V]) modified() bool {
+	return it.m.modCount != it.expectedModCount
+}
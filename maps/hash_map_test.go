@@ -0,0 +1,136 @@
+package maps
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/jorge-barroso/collections"
+	"github.com/jorge-barroso/collections/collectionstest"
+	"github.com/jorge-barroso/collections/hashing"
+	"github.com/stretchr/testify/assert"
+)
+
+// sliceHasher hashes and compares []int keys element by element
+var sliceHasher = hashing.HashSlice(intHasher)
+
+// caseInsensitiveHasher compares string keys regardless of their case
+var caseInsensitiveHasher = hashing.NewFuncHasher(
+	func(key string) uint64 { return hashing.NewFNVHash[string]().Hash(strings.ToLower(key)) },
+	strings.EqualFold,
+)
+
+// collidingHasher hashes every int key the same, so they all share a single chain
+var collidingHasher = hashing.NewFuncHasher(
+	func(int) uint64 { return 42 },
+	func(a, b int) bool { return a == b },
+)
+
+func TestHashMap_SliceKeys(t *testing.T) {
+	m := NewHashMap[[]int, string](sliceHasher)
+	m.Put([]int{1, 2}, "a")
+	m.Put([]int{2, 1}, "b")
+	m.Put([]int{1, 2}, "c")
+
+	assert.Equal(t, int64(2), m.Size(), "Equal slices should be the same key")
+	value, err := m.Get([]int{1, 2})
+	assert.NoError(t, err, "Unexpected error when getting an equal slice")
+	assert.Equal(t, "c", value, "Put should update the value of an equal key")
+	assert.True(t, m.ContainsKey([]int{2, 1}), "Expected key [2 1] to be in the map")
+	assert.False(t, m.ContainsKey([]int{1}), "Expected key [1] not to be in the map")
+
+	assert.NoError(t, m.Remove([]int{2, 1}), "Unexpected error when removing an equal slice")
+	assert.Error(t, m.Remove([]int{2, 1}), "Removing a missing key should fail")
+	assert.Equal(t, [][]int{{1, 2}}, slices.Collect(m.Keys()), "Keys mismatch after Remove()")
+}
+
+func TestHashMap_CustomEquality(t *testing.T) {
+	m := NewHashMap[string, int](caseInsensitiveHasher)
+	m.Put("Content-Type", 1)
+	m.Merge("content-type", 1, func(old, value int) (int, bool) { return old + value, true })
+
+	assert.Equal(t, int64(1), m.Size(), "Keys differing only in case should be the same key")
+	assert.Equal(t, 2, m.GetOrDefault("CONTENT-TYPE", 0), "Merge should have combined the values")
+	assert.Equal(t, []string{"Content-Type"}, slices.Collect(m.Keys()), "The first spelling of the key should be kept")
+}
+
+func TestHashMap_CollidingKeys(t *testing.T) {
+	m := NewHashMap[int, int](collidingHasher)
+	for i := 0; i < 10; i++ {
+		m.Put(i, i*i)
+	}
+
+	// Remove from the middle, the head and the tail of the chain, and the entry that was last in the table
+	for _, key := range []int{5, 9, 0, 8} {
+		assert.NoError(t, m.Remove(key), "Unexpected error when removing key %d", key)
+	}
+
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 6, 7}, slices.Collect(m.Keys()), "Keys mismatch after removing colliding keys")
+	for _, key := range []int{1, 2, 3, 4, 6, 7} {
+		value, err := m.Get(key)
+		assert.NoError(t, err, "Key %d should still be found", key)
+		assert.Equal(t, key*key, value, "Unexpected value for key %d", key)
+	}
+	for _, key := range []int{0, 5, 8, 9} {
+		assert.False(t, m.ContainsKey(key), "Key %d should be gone", key)
+	}
+
+	m.Put(5, 0)
+	assert.Equal(t, int64(7), m.Size(), "Removed keys should be insertable again")
+}
+
+func TestHashMap_IteratorConformance(t *testing.T) {
+	m := NewHashMap[string, int](caseInsensitiveHasher)
+	collectionstest.TestUnorderedIterable[Entry[string, int]](t, m, nil)
+
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	collectionstest.TestUnorderedIterable[Entry[string, int]](t, m, []Entry[string, int]{
+		{key: "a", value: 1},
+		{key: "b", value: 2},
+		{key: "c", value: 3},
+	})
+}
+
+func TestHashMapIterator_FailFast(t *testing.T) {
+	m := NewHashMap[int, int](intHasher)
+	for i := 0; i < 3; i++ {
+		m.Put(i, i)
+	}
+	next := 3
+	collectionstest.TestFailFastIterable[Entry[int, int]](t, m, func() {
+		m.Put(next, next)
+		next++
+	})
+
+	it := m.NewIterator()
+	it.Next()
+	assert.NoError(t, m.Remove(1), "Unexpected error when removing key 1")
+	_, err := it.Value()
+	assert.ErrorIs(t, err, collections.ErrConcurrentModification, "Expected the removal to be detected")
+}
+
+func TestHashMapIterator_Remove(t *testing.T) {
+	m := NewHashMap[int, int](collidingHasher)
+	for i := 0; i < 6; i++ {
+		m.Put(i, i)
+	}
+
+	var visited []int
+	it := m.NewMutableIterator()
+	for it.Next() {
+		entry, err := it.Value()
+		assert.NoError(t, err, "Unexpected error during iteration")
+		visited = append(visited, entry.Key())
+		if entry.Key()%2 == 0 {
+			assert.NoError(t, it.Remove(), "Unexpected error when removing through the iterator")
+			_, err := it.Value()
+			assert.ErrorIs(t, err, collections.ErrNoSuchElement, "The removed entry should no longer be current")
+		}
+	}
+
+	assert.ElementsMatch(t, []int{0, 1, 2, 3, 4, 5}, visited, "Every entry should be visited exactly once")
+	assert.ElementsMatch(t, []int{1, 3, 5}, slices.Collect(m.Keys()), "Keys mismatch after removing through the iterator")
+	assert.Equal(t, int64(3), m.Size(), "Size mismatch after removing through the iterator")
+}
@@ -0,0 +1,125 @@
+package maps
+
+import "github.com/jorge-barroso/collections/hashing"
+
+// hashTable stores key-value pairs in a dense slice indexed by the hash of their keys, comparing keys
+// with a Hasher so that they do not need to be comparable. Pairs whose keys share a hash are chained
+// through their positions in the slice. Removing a pair moves the last one into its place.
+type hashTable[K any, V any] struct {
+	hasher  hashing.Hasher[K]
+	heads   map[uint64]int // Position of the first pair of each hash
+	entries []hashedEntry[K, V]
+}
+
+// hashedEntry is a key-value pair along with the hash of its key, so it never needs to be rehashed
+type hashedEntry[K any, V any] struct {
+	Entry[K, V]
+	hash uint64
+	next int // Position of the next pair with the same hash, -1 at the end of the chain
+}
+
+// newHashTable creates an empty table comparing keys with hasher
+func newHashTable[K any, V any](hasher hashing.Hasher[K]) *hashTable[K, V] {
+	return &hashTable[K, V]{
+		hasher: hasher,
+		heads:  make(map[uint64]int),
+	}
+}
+
+// len returns the number of pairs in the table
+func (t *hashTable[K, V]) len() int {
+	return len(t.entries)
+}
+
+// find returns the position of the pair holding key, whose hash is given, or -1 if there is none
+func (t *hashTable[K, V]) find(hash uint64, key K) int {
+	i, ok := t.heads[hash]
+	if !ok {
+		return -1
+	}
+	for ; i >= 0; i = t.entries[i].next {
+		if t.hasher.Equal(t.entries[i].key, key) {
+			return i
+		}
+	}
+	return -1
+}
+
+// get returns the value associated with key, whose hash is given, and whether the key is present
+func (t *hashTable[K, V]) get(hash uint64, key K) (V, bool) {
+	if i := t.find(hash, key); i >= 0 {
+		return t.entries[i].value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// put inserts or updates a key-value pair, reporting whether the key was added
+func (t *hashTable[K, V]) put(hash uint64, key K, value V) bool {
+	if i := t.find(hash, key); i >= 0 {
+		t.entries[i].value = value
+		return false
+	}
+	t.insert(hash, key, value)
+	return true
+}
+
+// insert adds a key-value pair whose key is known not to be in the table
+func (t *hashTable[K, V]) insert(hash uint64, key K, value V) {
+	next, ok := t.heads[hash]
+	if !ok {
+		next = -1
+	}
+	t.heads[hash] = len(t.entries)
+	t.entries = append(t.entries, hashedEntry[K, V]{Entry: Entry[K, V]{key: key, value: value}, hash: hash, next: next})
+}
+
+// remove deletes key, whose hash is given, reporting whether it was present
+func (t *hashTable[K, V]) remove(hash uint64, key K) bool {
+	i := t.find(hash, key)
+	if i < 0 {
+		return false
+	}
+	t.removeAt(i)
+	return true
+}
+
+// removeAt deletes the pair at position i and moves the last pair into its place
+func (t *hashTable[K, V]) removeAt(i int) {
+	t.relink(i, t.entries[i].next)
+
+	last := len(t.entries) - 1
+	if i != last {
+		t.relink(last, i)
+		t.entries[i] = t.entries[last]
+	}
+	t.entries[last] = hashedEntry[K, V]{} // Let go of the key and value
+	t.entries = t.entries[:last]
+}
+
+// relink makes whatever points to position i in its chain point to position to instead,
+// dropping the head of the chain when it is left empty
+func (t *hashTable[K, V]) relink(i, to int) {
+	hash := t.entries[i].hash
+	head := t.heads[hash]
+	if head == i {
+		if to < 0 {
+			delete(t.heads, hash)
+		} else {
+			t.heads[hash] = to
+		}
+		return
+	}
+
+	prev := head
+	for t.entries[prev].next != i {
+		prev = t.entries[prev].next
+	}
+	t.entries[prev].next = to
+}
+
+// clear removes every pair from the table
+func (t *hashTable[K, V]) clear() {
+	t.heads = make(map[uint64]int)
+	t.entries = nil
+}
@@ -2,15 +2,18 @@ package maps
 
 import (
 	"errors"
+	"iter"
+
 	"github.com/jorge-barroso/collections"
 )
 
 // LinkedHashMap implements both Map and collections.Iterable interfaces
 type LinkedHashMap[K comparable, V any] struct {
-	items map[K]*collections.Node[Entry[K, V]]
-	head  *collections.Node[Entry[K, V]]
-	tail  *collections.Node[Entry[K, V]]
-	size  int64
+	items    map[K]*collections.Node[Entry[K, V]]
+	head     *collections.Node[Entry[K, V]]
+	tail     *collections.Node[Entry[K, V]]
+	size     int64
+	modCount int // Number of structural modifications, used by iterators to fail fast
 }
 
 // Ensure LinkedHashMap implements both Map and Iterable interfaces
@@ -57,6 +60,7 @@ func (m *LinkedHashMap[K, V]) Put(key K, value V) {
 	}
 
 	m.size++
+	m.modCount++
 }
 
 // Get retrieves the value associated with a key
@@ -68,6 +72,32 @@ func (m *LinkedHashMap[K, V]) Get(key K) (V, error) {
 	return zero, errors.New("key not found")
 }
 
+// GetOrDefault retrieves the value associated with a key, or defaultValue if the key is not in the map
+func (m *LinkedHashMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	if node, ok := m.items[key]; ok {
+		return node.Item.Value()
+	}
+	return defaultValue
+}
+
+// PutAll inserts or updates every key-value pair of other, new keys are appended in the order of other
+func (m *LinkedHashMap[K, V]) PutAll(other Map[K, V]) {
+	for key, value := range other.All() {
+		m.Put(key, value)
+	}
+}
+
+// ContainsKey reports whether the key is in the map
+func (m *LinkedHashMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.items[key]
+	return ok
+}
+
+// ContainsValue reports whether any key is associated with a value equal to value according to eq
+func (m *LinkedHashMap[K, V]) ContainsValue(value V, eq func(a, b V) bool) bool {
+	return containsValue(m.Values(), value, eq)
+}
+
 // Remove removes a key-value pair
 func (m *LinkedHashMap[K, V]) Remove(key K) error {
 	target, ok := m.items[key]
@@ -75,41 +105,36 @@ func (m *LinkedHashMap[K, V]) Remove(key K) error {
 		return errors.New("key not found")
 	}
 
-	// Remove from hashmap
-	delete(m.items, key)
-
-	// Special case: single element
-	if m.head == m.tail {
-		m.head = nil
-		m.tail = nil
-		m.size--
-		return nil
+	// Find the previous node, nil when removing the head
+	var prev *collections.Node[Entry[K, V]]
+	if m.head != target {
+		prev = m.head
+		for prev.Next != target {
+			prev = prev.Next
+		}
 	}
 
-	// Special case: remove head
-	if m.head == target {
-		m.head = m.head.Next
-		m.size--
-		return nil
-	}
+	m.unlink(prev, target)
+	return nil
+}
 
-	// Find the previous node
-	current := m.head
-	for current != nil && current.Next != target {
-		current = current.Next
-	}
+// unlink removes target from both the hashmap and the linked list given its predecessor
+func (m *LinkedHashMap[K, V]) unlink(prev, target *collections.Node[Entry[K, V]]) {
+	// Remove from hashmap
+	delete(m.items, target.Item.Key())
 
-	if current != nil {
-		// Remove the target node
-		current.Next = target.Next
-		// Update tail if needed
-		if target == m.tail {
-			m.tail = current
-		}
+	if prev == nil {
+		m.head = target.Next
+	} else {
+		prev.Next = target.Next
+	}
+	// Update tail if needed
+	if target == m.tail {
+		m.tail = prev
 	}
 
 	m.size--
-	return nil
+	m.modCount++
 }
 
 // Size returns the number of key-value pairs
@@ -117,9 +142,56 @@ func (m *LinkedHashMap[K, V]) Size() int64 {
 	return m.size
 }
 
+// IsEmpty reports whether the map has no key-value pairs
+func (m *LinkedHashMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Clear removes every key-value pair
+func (m *LinkedHashMap[K, V]) Clear() {
+	m.items = make(map[K]*collections.Node[Entry[K, V]])
+	m.head = nil
+	m.tail = nil
+	m.size = 0
+	m.modCount++
+}
+
 // NewIterator returns a new iterator for the LinkedHashMap
 func (m *LinkedHashMap[K, V]) NewIterator() collections.Iterator[Entry[K, V]] {
+	return m.NewMutableIterator()
+}
+
+// NewMutableIterator returns a new iterator that can also remove entries in insertion order
+func (m *LinkedHashMap[K, V]) NewMutableIterator() collections.MutableIterator[Entry[K, V]] {
 	return &LinkedHashMapIterator[K, V]{
-		current: m.head,
+		m:                m,
+		next:             m.head,
+		expectedModCount: m.modCount,
 	}
 }
+
+// All returns an iter.Seq2 over the key-value pairs in insertion order
+func (m *LinkedHashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for current := m.head; current != nil; current = current.Next {
+			if !yield(current.Item.Key(), current.Item.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iter.Seq over the keys in insertion order
+func (m *LinkedHashMap[K, V]) Keys() iter.Seq[K] {
+	return keysOf(m.All())
+}
+
+// Values returns an iter.Seq over the values in insertion order
+func (m *LinkedHashMap[K, V]) Values() iter.Seq[V] {
+	return valuesOf(m.All())
+}
+
+// Entries returns an iter.Seq over the key-value pairs in insertion order
+func (m *LinkedHashMap[K, V]) Entries() iter.Seq[Entry[K, V]] {
+	return entriesOf(m.All())
+}
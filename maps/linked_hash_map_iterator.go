@@ -1,27 +1,78 @@
 package maps
 
 import (
-	"errors"
 	"github.com/jorge-barroso/collections"
 )
 
 // LinkedHashMapIterator implements the Iterator interface
 type LinkedHashMapIterator[K comparable, V any] struct {
-	current *collections.Node[Entry[K, V]]
+	m                *LinkedHashMap[K, V]
+	prev             *collections.Node[Entry[K, V]] // Node preceding the cursor, used to unlink in O(1)
+	current          *collections.Node[Entry[K, V]] // Node the iterator is positioned on
+	next             *collections.Node[Entry[K, V]] // Node the next call to Next moves to
+	expectedModCount int                            // modCount of the map when the iterator last synchronised with it
+	failed           bool                           // Set once a concurrent modification has been reported by Next
 }
 
-// Next checks if there are more elements
+// Ensure LinkedHashMapIterator implements MutableIterator
+var _ collections.MutableIterator[Entry[string, int]] = (*LinkedHashMapIterator[string, int])(nil)
+
+// Next advances the iterator to the next entry in insertion order
 func (it *LinkedHashMapIterator[K, V]) Next() bool {
-	return it.current != nil
+	if it.modified() {
+		if it.failed {
+			return false
+		}
+		it.failed = true
+		return true
+	}
+
+	if it.next == nil {
+		return false
+	}
+
+	// A removed node leaves prev untouched, as it still precedes the next node
+	if it.current != nil {
+		it.prev = it.current
+	}
+	it.current = it.next
+	it.next = it.current.Next
+	return true
 }
 
-// Value returns the current element and advances the iterator
+// Value returns the element the iterator is positioned on
 func (it *LinkedHashMapIterator[K, V]) Value() (Entry[K, V], error) {
+	var zero Entry[K, V]
+	if err := it.checkPositioned(); err != nil {
+		return zero, err
+	}
+	return it.current.Item, nil
+}
+
+// Remove deletes the entry the iterator is positioned on in constant time
+func (it *LinkedHashMapIterator[K, V]) Remove() error {
+	if err := it.checkPositioned(); err != nil {
+		return err
+	}
+
+	it.m.unlink(it.prev, it.current)
+	it.current = nil
+	it.expectedModCount = it.m.modCount
+	return nil
+}
+
+// checkPositioned returns an error unless the iterator sits on a valid, unmodified node
+func (it *LinkedHashMapIterator[K, V]) checkPositioned() error {
+	if it.modified() {
+		return collections.ErrConcurrentModification
+	}
 	if it.current == nil {
-		var zero Entry[K, V]
-		return zero, errors.New("no more elements")
+		return collections.ErrNoSuchElement
 	}
-	value := it.current.Item
-	it.current = it.current.Next
-	return value, nil
+	return nil
+}
+
+// modified reports whether the map has been structurally modified behind the iterator
+func (it *LinkedHashMapIterator[K, V]) modified() bool {
+	return it.m.modCount != it.expectedModCount
 }
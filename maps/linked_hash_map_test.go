@@ -1,8 +1,11 @@
 package maps
 
 import (
+	"slices"
 	"testing"
 
+	"github.com/jorge-barroso/collections"
+	"github.com/jorge-barroso/collections/collectionstest"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -131,7 +134,84 @@ func TestLinkedHashMapIterator_SingleElement(t *testing.T) {
 	assert.Equal(t, 1, entry.Value(), "Value mismatch for single element in iterator")
 
 	assert.False(t, it.Next(), "Expected Next() to return false after the last element")
-	_, err = it.Value()
-	assert.Error(t, err, "Expected error when retrieving value after iterator end")
-	assert.Equal(t, "no more elements", err.Error(), "Unexpected error message after iterator end")
+	entry, err = it.Value()
+	assert.NoError(t, err, "Value() should not return an error after iteration but hold the last value")
+	assert.Equal(t, "a", entry.Key(), "Key mismatch after iterator end, Next() should not have moved past the last position")
+}
+
+func TestLinkedHashMap_All(t *testing.T) {
+	m := NewLinkedHashMap[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	var keys []string
+	for k := range m.All() {
+		keys = append(keys, k)
+		if k == "a" {
+			break
+		}
+	}
+	assert.Equal(t, []string{"c", "a"}, keys, "Expected insertion order up to the break")
+
+	assert.Equal(t, []string{"c", "a", "b"}, slices.Collect(m.Keys()), "Keys() should follow insertion order")
+	assert.Equal(t, []int{3, 1, 2}, slices.Collect(m.Values()), "Values() should follow insertion order")
+}
+
+func TestLinkedHashMap_IteratorConformance(t *testing.T) {
+	m := NewLinkedHashMap[string, int]()
+	collectionstest.TestIterable[Entry[string, int]](t, m, nil)
+
+	m.Put("b", 2)
+	m.Put("a", 1)
+	m.Put("c", 3)
+	collectionstest.TestIterable[Entry[string, int]](t, m, []Entry[string, int]{
+		{key: "b", value: 2},
+		{key: "a", value: 1},
+		{key: "c", value: 3},
+	})
+}
+
+func TestLinkedHashMapIterator_FailFast(t *testing.T) {
+	m := NewLinkedHashMap[int, int]()
+	for i := 0; i < 3; i++ {
+		m.Put(i, i)
+	}
+	next := 3
+	collectionstest.TestFailFastIterable[Entry[int, int]](t, m, func() {
+		m.Put(next, next)
+		next++
+	})
+
+	it := m.NewIterator()
+	it.Next()
+	assert.NoError(t, m.Remove(1), "Unexpected error when removing key 1")
+	_, err := it.Value()
+	assert.ErrorIs(t, err, collections.ErrConcurrentModification, "Expected the removal to be detected")
+}
+
+func TestLinkedHashMapIterator_Remove(t *testing.T) {
+	m := NewLinkedHashMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("d", 4)
+
+	it := m.NewMutableIterator()
+	for it.Next() {
+		entry, err := it.Value()
+		assert.NoError(t, err, "Unexpected error during iteration")
+		if entry.Key() != "c" {
+			assert.NoError(t, it.Remove(), "Unexpected error when removing through the iterator")
+		}
+	}
+
+	assert.Equal(t, []string{"c"}, slices.Collect(m.Keys()), "Keys mismatch after removing through the iterator")
+	assert.Equal(t, int64(1), m.Size(), "Size mismatch after removing through the iterator")
+	_, err := m.Get("a")
+	assert.Error(t, err, "Removed keys should no longer be found")
+
+	// The tail must have been updated when the last entry was removed
+	m.Put("e", 5)
+	assert.Equal(t, []string{"c", "e"}, slices.Collect(m.Keys()), "Keys mismatch after appending past the removed tail")
 }
@@ -1,8 +1,36 @@
 package maps
 
-type Map[K comparable, V any] interface {
-	Put(key K, value V)   // Inserts or updates a key-value pair
-	Get(key K) (V, error) // Retrieves the value associated with a key
-	Remove(key K) error   // Removes a key-value pair
-	Size() int64          // Returns the number of key-value pairs
+import "iter"
+
+// Map interface defining common map operations.
+// Methods that need to compare values take an equality function so that V is not required to be comparable.
+type Map[K any, V any] interface {
+	Put(key K, value V)                               // Inserts or updates a key-value pair
+	PutAll(other Map[K, V])                           // Inserts or updates every key-value pair of other
+	Get(key K) (V, error)                             // Retrieves the value associated with a key
+	GetOrDefault(key K, defaultValue V) V             // Retrieves the value associated with a key, or defaultValue
+	Remove(key K) error                               // Removes a key-value pair
+	ContainsKey(key K) bool                           // Reports whether the key is in the map
+	ContainsValue(value V, eq func(a, b V) bool) bool // Reports whether any key is associated with value
+	Keys() iter.Seq[K]                                // Returns the keys in the natural order of the map
+	Values() iter.Seq[V]                              // Returns the values in the natural order of the map
+	Entries() iter.Seq[Entry[K, V]]                   // Returns the key-value pairs in the natural order of the map
+	All() iter.Seq2[K, V]                             // Returns the key-value pairs in the natural order of the map
+	Clear()                                           // Removes every key-value pair
+	Size() int64                                      // Returns the number of key-value pairs
+	IsEmpty() bool                                    // Reports whether the map has no key-value pairs
+	ComputeMap[K, V]
+}
+
+// ComputeMap groups the operations that read and update the value associated with a key in a single step.
+// Remapping functions return false to remove the key. Implementations safe for concurrent use run
+// each operation atomically, so the functions passed to them must not use the map themselves.
+type ComputeMap[K any, V any] interface {
+	PutIfAbsent(key K, value V) (V, bool)                                            // Stores value unless key is present, returning the current value and whether it was present
+	ComputeIfAbsent(key K, mapping func(key K) V) V                                  // Stores the result of mapping unless key is present, returning the current value
+	ComputeIfPresent(key K, remapping func(key K, value V) (V, bool)) (V, bool)      // Remaps the value of key if it is present
+	Compute(key K, remapping func(key K, value V, present bool) (V, bool)) (V, bool) // Remaps the value of key whether it is present or not
+	Merge(key K, value V, merging func(old, value V) (V, bool)) (V, bool)            // Stores value if key is absent, otherwise merges it with the current value
+	Replace(key K, oldValue, newValue V, eq func(a, b V) bool) bool                  // Replaces the value of key only if it is equal to oldValue
+	RemoveIf(key K, expected V, eq func(a, b V) bool) bool                           // Removes key only if its value is equal to expected
 }
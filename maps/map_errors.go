@@ -0,0 +1,17 @@
+package maps
+
+import "errors"
+
+var (
+	// errMapEmpty is returned when attempting to retrieve or remove an entry from either end of an empty map
+	errMapEmpty = errors.New("map is empty")
+
+	// errKeyOutOfRange is returned when inserting a key outside the range of a TreeSubMap
+	errKeyOutOfRange = errors.New("key out of range")
+
+	// errInvalidRange is returned when a view is requested with bounds that are reversed or outside its parent
+	errInvalidRange = errors.New("invalid key range")
+
+	// errCorruptTree is wrapped by the errors TreeMap.Validate returns for each broken invariant
+	errCorruptTree = errors.New("corrupt red-black tree")
+)
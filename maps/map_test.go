@@ -0,0 +1,235 @@
+package maps
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jorge-barroso/collections/hashing"
+	"github.com/stretchr/testify/assert"
+)
+
+// mapImplementations lists every Map implementation so behaviour shared through the
+// interface can be checked once for all of them
+var mapImplementations = map[string]func() Map[int, string]{
+	"TreeMap":           func() Map[int, string] { return NewTreeMap[int, string](func(a, b int) bool { return a < b }) },
+	"LinkedHashMap":     func() Map[int, string] { return NewLinkedHashMap[int, string]() },
+	"ConcurrentHashMap": func() Map[int, string] { return NewConcurrentHashMap[int, string]() },
+	"HashMap":           func() Map[int, string] { return NewHashMap[int, string](intHasher) },
+	"TreeSubMap":        newPaddedTreeSubMap,
+}
+
+// newPaddedTreeSubMap returns an empty view over the keys [0, 100) of a TreeMap holding keys on either
+// side of the range, so entries of the TreeMap leaking into the view would show up as unexpected keys
+func newPaddedTreeSubMap() Map[int, string] {
+	tree := NewTreeMap[int, string](func(a, b int) bool { return a < b })
+	tree.Put(-1, "outside")
+	tree.Put(100, "outside")
+	view, _ := tree.SubMap(0, 100)
+	return view
+}
+
+// intHasher hashes and compares int keys for the maps taking a hasher
+var intHasher = hashing.NewComparableHasher[int](hashing.NewFNVHash[int]())
+
+func stringEquals(a, b string) bool { return a == b }
+
+// forEachMap runs the test against every Map implementation, after putting the given keys
+// associated with their string representation
+func forEachMap(t *testing.T, keys []int, test func(t *testing.T, m Map[int, string])) {
+	for name, newMap := range mapImplementations {
+		t.Run(name, func(t *testing.T) {
+			m := newMap()
+			for _, key := range keys {
+				m.Put(key, string(rune('a'+key)))
+			}
+			test(t, m)
+		})
+	}
+}
+
+func TestMap_Contains(t *testing.T) {
+	forEachMap(t, []int{1, 2, 3}, func(t *testing.T, m Map[int, string]) {
+		assert.True(t, m.ContainsKey(2), "Expected key 2 to be in the map")
+		assert.False(t, m.ContainsKey(4), "Expected key 4 not to be in the map")
+
+		assert.True(t, m.ContainsValue("c", stringEquals), "Expected value c to be in the map")
+		assert.False(t, m.ContainsValue("z", stringEquals), "Expected value z not to be in the map")
+
+		assert.NoError(t, m.Remove(2), "Unexpected error when removing key 2")
+		assert.False(t, m.ContainsKey(2), "Expected key 2 to be gone after Remove()")
+		assert.False(t, m.ContainsValue("c", stringEquals), "Expected value c to be gone after Remove()")
+	})
+}
+
+func TestMap_GetOrDefault(t *testing.T) {
+	forEachMap(t, []int{1}, func(t *testing.T, m Map[int, string]) {
+		assert.Equal(t, "b", m.GetOrDefault(1, "none"), "GetOrDefault() should return the value of an existing key")
+		assert.Equal(t, "none", m.GetOrDefault(2, "none"), "GetOrDefault() should return the default for a missing key")
+		assert.False(t, m.ContainsKey(2), "GetOrDefault() should not insert the missing key")
+	})
+}
+
+func TestMap_ClearAndIsEmpty(t *testing.T) {
+	forEachMap(t, nil, func(t *testing.T, m Map[int, string]) {
+		assert.True(t, m.IsEmpty(), "Expected a new map to be empty")
+
+		m.Put(1, "b")
+		m.Put(2, "c")
+		assert.False(t, m.IsEmpty(), "Expected a non-empty map after Put()")
+
+		m.Clear()
+		assert.True(t, m.IsEmpty(), "Expected an empty map after Clear()")
+		assert.Equal(t, int64(0), m.Size(), "Expected size 0 after Clear()")
+		assert.Empty(t, slices.Collect(m.Keys()), "Expected no keys after Clear()")
+
+		m.Put(3, "d")
+		assert.Equal(t, []int{3}, slices.Collect(m.Keys()), "The map should be usable after Clear()")
+	})
+}
+
+func TestMap_PutAll(t *testing.T) {
+	forEachMap(t, []int{1, 2}, func(t *testing.T, m Map[int, string]) {
+		other := NewLinkedHashMap[int, string]()
+		other.Put(2, "two")
+		other.Put(3, "three")
+
+		m.PutAll(other)
+		assert.Equal(t, int64(3), m.Size(), "Size mismatch after PutAll()")
+		assert.Equal(t, "b", m.GetOrDefault(1, ""), "PutAll() should keep keys missing from the other map")
+		assert.Equal(t, "two", m.GetOrDefault(2, ""), "PutAll() should update existing keys")
+		assert.Equal(t, "three", m.GetOrDefault(3, ""), "PutAll() should insert new keys")
+
+		m.PutAll(m)
+		assert.Equal(t, int64(3), m.Size(), "PutAll() of the map into itself should not change it")
+	})
+}
+
+func TestMap_Entries(t *testing.T) {
+	forEachMap(t, []int{3, 1, 2}, func(t *testing.T, m Map[int, string]) {
+		var keys []int
+		var values []string
+		for entry := range m.Entries() {
+			keys = append(keys, entry.Key())
+			values = append(values, entry.Value())
+		}
+
+		assert.Equal(t, slices.Collect(m.Keys()), keys, "Entries() and Keys() should follow the same order")
+		assert.Equal(t, slices.Collect(m.Values()), values, "Entries() and Values() should follow the same order")
+		assert.ElementsMatch(t, []int{1, 2, 3}, keys, "Entries() visited unexpected keys")
+	})
+}
+
+func TestMap_EntriesOrder(t *testing.T) {
+	tree := NewTreeMap[int, string](func(a, b int) bool { return a < b })
+	linked := NewLinkedHashMap[int, string]()
+	for _, key := range []int{3, 1, 2} {
+		tree.Put(key, "")
+		linked.Put(key, "")
+	}
+
+	var treeKeys, linkedKeys []int
+	for entry := range tree.Entries() {
+		treeKeys = append(treeKeys, entry.Key())
+	}
+	for entry := range linked.Entries() {
+		linkedKeys = append(linkedKeys, entry.Key())
+	}
+	assert.Equal(t, []int{1, 2, 3}, treeKeys, "TreeMap entries should be in ascending key order")
+	assert.Equal(t, []int{3, 1, 2}, linkedKeys, "LinkedHashMap entries should be in insertion order")
+}
+
+func TestMap_PutIfAbsent(t *testing.T) {
+	forEachMap(t, []int{1}, func(t *testing.T, m Map[int, string]) {
+		value, loaded := m.PutIfAbsent(1, "x")
+		assert.True(t, loaded, "PutIfAbsent() should report an existing key")
+		assert.Equal(t, "b", value, "PutIfAbsent() should return the existing value")
+
+		value, loaded = m.PutIfAbsent(2, "x")
+		assert.False(t, loaded, "PutIfAbsent() should report a missing key")
+		assert.Equal(t, "x", value, "PutIfAbsent() should return the stored value")
+		assert.Equal(t, int64(2), m.Size(), "Size mismatch after PutIfAbsent()")
+	})
+}
+
+func TestMap_ComputeIfAbsentAndPresent(t *testing.T) {
+	forEachMap(t, []int{1}, func(t *testing.T, m Map[int, string]) {
+		calls := 0
+		mapping := func(key int) string {
+			calls++
+			return "computed"
+		}
+		assert.Equal(t, "b", m.ComputeIfAbsent(1, mapping), "ComputeIfAbsent() should return the existing value")
+		assert.Equal(t, "computed", m.ComputeIfAbsent(2, mapping), "ComputeIfAbsent() should return the computed value")
+		assert.Equal(t, 1, calls, "ComputeIfAbsent() should only call mapping for missing keys")
+
+		value, ok := m.ComputeIfPresent(1, func(key int, value string) (string, bool) { return value + "!", true })
+		assert.True(t, ok, "ComputeIfPresent() should keep the key")
+		assert.Equal(t, "b!", value, "ComputeIfPresent() should return the remapped value")
+
+		_, ok = m.ComputeIfPresent(3, func(key int, value string) (string, bool) { return "never", true })
+		assert.False(t, ok, "ComputeIfPresent() should not insert missing keys")
+		assert.False(t, m.ContainsKey(3), "ComputeIfPresent() should not insert missing keys")
+
+		_, ok = m.ComputeIfPresent(2, func(key int, value string) (string, bool) { return "", false })
+		assert.False(t, ok, "ComputeIfPresent() should report the removal")
+		assert.False(t, m.ContainsKey(2), "ComputeIfPresent() should remove the key when remapping returns false")
+		assert.Equal(t, int64(1), m.Size(), "Size mismatch after removing through ComputeIfPresent()")
+	})
+}
+
+func TestMap_Compute(t *testing.T) {
+	forEachMap(t, nil, func(t *testing.T, m Map[int, string]) {
+		appendDot := func(key int, value string, present bool) (string, bool) {
+			if !present {
+				return ".", true
+			}
+			return value + ".", len(value) < 2
+		}
+
+		value, ok := m.Compute(1, appendDot)
+		assert.True(t, ok, "Compute() should insert a missing key")
+		assert.Equal(t, ".", value, "Compute() value mismatch for a missing key")
+
+		value, _ = m.Compute(1, appendDot)
+		assert.Equal(t, "..", value, "Compute() value mismatch for an existing key")
+
+		_, ok = m.Compute(1, appendDot)
+		assert.False(t, ok, "Compute() should remove the key when remapping returns false")
+		assert.True(t, m.IsEmpty(), "Expected an empty map after removing through Compute()")
+
+		_, ok = m.Compute(2, func(key int, value string, present bool) (string, bool) { return "", false })
+		assert.False(t, ok, "Compute() should not insert when remapping returns false")
+		assert.True(t, m.IsEmpty(), "Compute() should not insert when remapping returns false")
+	})
+}
+
+func TestMap_Merge(t *testing.T) {
+	forEachMap(t, nil, func(t *testing.T, m Map[int, string]) {
+		concat := func(old, value string) (string, bool) { return old + value, old+value != "xyz" }
+
+		value, ok := m.Merge(1, "x", concat)
+		assert.True(t, ok, "Merge() should insert a missing key")
+		assert.Equal(t, "x", value, "Merge() should store the value of a missing key as is")
+
+		value, _ = m.Merge(1, "y", concat)
+		assert.Equal(t, "xy", value, "Merge() should combine the values of an existing key")
+
+		_, ok = m.Merge(1, "z", concat)
+		assert.False(t, ok, "Merge() should remove the key when merging returns false")
+		assert.False(t, m.ContainsKey(1), "Merge() should remove the key when merging returns false")
+	})
+}
+
+func TestMap_ReplaceAndRemoveIf(t *testing.T) {
+	forEachMap(t, []int{1}, func(t *testing.T, m Map[int, string]) {
+		assert.False(t, m.Replace(1, "x", "y", stringEquals), "Replace() should fail when the value does not match")
+		assert.False(t, m.Replace(2, "c", "y", stringEquals), "Replace() should fail for a missing key")
+		assert.True(t, m.Replace(1, "b", "y", stringEquals), "Replace() should succeed when the value matches")
+		assert.Equal(t, "y", m.GetOrDefault(1, ""), "Value mismatch after Replace()")
+
+		assert.False(t, m.RemoveIf(1, "b", stringEquals), "RemoveIf() should fail when the value does not match")
+		assert.False(t, m.RemoveIf(2, "y", stringEquals), "RemoveIf() should fail for a missing key")
+		assert.True(t, m.RemoveIf(1, "y", stringEquals), "RemoveIf() should succeed when the value matches")
+		assert.True(t, m.IsEmpty(), "Expected an empty map after RemoveIf()")
+	})
+}
@@ -17,6 +17,7 @@ type rbNode[K comparable, V any] struct {
 	left   *rbNode[K, V]
 	right  *rbNode[K, V]
 	parent *rbNode[K, V]
+	count  int64 // Number of nodes in the subtree rooted at this node, itself included
 }
 
 // newRBNode creates a new Red-Black tree node
@@ -26,6 +27,7 @@ func newRBNode[K comparable, V any](entry Entry[K, V]) *rbNode[K, V] {
 			Item: entry,
 		},
 		color: red, // New nodes are always red
+		count: 1,
 	}
 }
 
@@ -39,6 +41,19 @@ func (n *rbNode[K, V]) isBlack() bool {
 	return n == nil || n.color == black
 }
 
+// subtreeSize returns the number of nodes in the subtree rooted at the node, 0 if it is nil
+func (n *rbNode[K, V]) subtreeSize() int64 {
+	if n == nil {
+		return 0
+	}
+	return n.count
+}
+
+// updateCount recomputes the size of the subtree rooted at the node from the sizes of its children
+func (n *rbNode[K, V]) updateCount() {
+	n.count = n.left.subtreeSize() + n.right.subtreeSize() + 1
+}
+
 // getGrandparent returns the grandparent of the node, if it exists
 func (n *rbNode[K, V]) getGrandparent() *rbNode[K, V] {
 	if n != nil && n.parent != nil {
@@ -100,3 +115,29 @@ func (n *rbNode[K, V]) getMinimum() *rbNode[K, V] {
 	}
 	return current
 }
+
+// getPredecessor returns the in-order predecessor of the node
+func (n *rbNode[K, V]) getPredecessor() *rbNode[K, V] {
+	if n.left != nil {
+		return n.left.getMaximum()
+	}
+
+	// Otherwise, go up until we find a parent where the current node
+	// is in the right subtree
+	current := n
+	parent := current.parent
+	for parent != nil && current == parent.left {
+		current = parent
+		parent = parent.parent
+	}
+	return parent
+}
+
+// getMaximum returns the maximum node in the subtree rooted at this node
+func (n *rbNode[K, V]) getMaximum() *rbNode[K, V] {
+	current := n
+	for current.right != nil {
+		current = current.right
+	}
+	return current
+}
@@ -0,0 +1,46 @@
+package maps
+
+import "iter"
+
+// keysOf projects the keys out of a key-value sequence
+func keysOf[K any, V any](all iter.Seq2[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range all {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// valuesOf projects the values out of a key-value sequence
+func valuesOf[K any, V any](all iter.Seq2[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range all {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// entriesOf packs the pairs of a key-value sequence into entries
+func entriesOf[K any, V any](all iter.Seq2[K, V]) iter.Seq[Entry[K, V]] {
+	return func(yield func(Entry[K, V]) bool) {
+		for key, value := range all {
+			if !yield(Entry[K, V]{key: key, value: value}) {
+				return
+			}
+		}
+	}
+}
+
+// containsValue reports whether any value of the sequence is equal to value according to eq
+func containsValue[V any](values iter.Seq[V], value V, eq func(a, b V) bool) bool {
+	for candidate := range values {
+		if eq(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
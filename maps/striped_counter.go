@@ -0,0 +1,48 @@
+package maps
+
+import (
+	"runtime"
+	"sync/atomic"
+)
+
+// cacheLineSize is the size the cells of a stripedCounter are padded to, so that updates to
+// neighbouring cells do not invalidate each other's cache line
+const cacheLineSize = 64
+
+// stripedCounter is a counter spread over several cells, in the style of Java's LongAdder.
+// Writers only touch the cell picked by a hint, so writers with different hints rarely contend,
+// while reading the total has to add every cell up.
+type stripedCounter struct {
+	cells []counterCell
+	mask  uint64
+}
+
+// counterCell is a single padded cell of a stripedCounter
+type counterCell struct {
+	value atomic.Int64
+	_     [cacheLineSize - 8]byte
+}
+
+// newStripedCounter creates a counter with about one cell per processor
+func newStripedCounter() *stripedCounter {
+	count := nextPowerOfTwo(runtime.GOMAXPROCS(0))
+	return &stripedCounter{
+		cells: make([]counterCell, count),
+		mask:  uint64(count - 1),
+	}
+}
+
+// add adds delta to the cell selected by hint
+func (c *stripedCounter) add(hint uint64, delta int64) {
+	c.cells[hint&c.mask].value.Add(delta)
+}
+
+// sum adds every cell up. The cells are read one at a time, so the result is only exact
+// when no update runs concurrently
+func (c *stripedCounter) sum() int64 {
+	var total int64
+	for i := range c.cells {
+		total += c.cells[i].value.Load()
+	}
+	return total
+}
@@ -0,0 +1,28 @@
+package maps
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStripedCounter(t *testing.T) {
+	counter := newStripedCounter()
+	assert.Zero(t, counter.sum(), "Expected a new counter to be zero")
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				counter.add(uint64(g*1000+i), 2)
+				counter.add(uint64(i), -1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int64(8000), counter.sum(), "Sum mismatch after concurrent updates")
+}
@@ -1,28 +1,43 @@
 package maps
 
 import (
+	"cmp"
 	"errors"
+	"iter"
+
 	"github.com/jorge-barroso/collections"
 )
 
 // TreeMap implements a sorted map using a Red-Black tree
 type TreeMap[K comparable, V any] struct {
-	root *rbNode[K, V]
-	size int64
-	less func(a, b K) bool // Comparison function for keys
+	root     *rbNode[K, V]
+	size     int64
+	compare  collections.Comparator[K] // Three-way comparison function for keys
+	modCount int                       // Number of structural modifications, used by iterators to fail fast
 }
 
 // Ensure TreeMap implements both Map and Iterable interfaces
 var _ Map[string, int] = (*TreeMap[string, int])(nil)
 var _ collections.Iterable[Entry[string, int]] = (*TreeMap[string, int])(nil)
 
-// NewTreeMap creates a new TreeMap with a custom comparison function
+// NewTreeMap creates a new TreeMap with a custom comparison function.
+// Each comparison may call less twice, NewTreeMapWithComparator only needs one call.
 func NewTreeMap[K comparable, V any](less func(a, b K) bool) *TreeMap[K, V] {
+	return NewTreeMapWithComparator[K, V](collections.FromLess(less))
+}
+
+// NewTreeMapWithComparator creates a new TreeMap ordering its keys with a three-way comparison function
+func NewTreeMapWithComparator[K comparable, V any](compare collections.Comparator[K]) *TreeMap[K, V] {
 	return &TreeMap[K, V]{
-		less: less,
+		compare: compare,
 	}
 }
 
+// NewOrderedTreeMap creates a new TreeMap ordering its keys from the smallest to the largest
+func NewOrderedTreeMap[K cmp.Ordered, V any]() *TreeMap[K, V] {
+	return NewTreeMapWithComparator[K, V](cmp.Compare[K])
+}
+
 // Put inserts or updates a key-value pair
 func (t *TreeMap[K, V]) Put(key K, value V) {
 	entry := Entry[K, V]{
@@ -30,16 +45,7 @@ func (t *TreeMap[K, V]) Put(key K, value V) {
 		value: value,
 	}
 
-	newNode := newRBNode(entry)
-
-	if t.root == nil {
-		t.root = newNode
-		t.size++
-		t.insertFixup(newNode)
-		return
-	}
-
-	t.insert(newNode)
+	t.insert(newRBNode(entry))
 }
 
 // Insert helper methods moved to tree_map_ops.go
@@ -54,6 +60,31 @@ func (t *TreeMap[K, V]) Get(key K) (V, error) {
 	return node.Node.Item.Value(), nil
 }
 
+// GetOrDefault retrieves the value associated with a key, or defaultValue if the key is not in the map
+func (t *TreeMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	if node := t.findNode(key); node != nil {
+		return node.Node.Item.Value()
+	}
+	return defaultValue
+}
+
+// PutAll inserts or updates every key-value pair of other
+func (t *TreeMap[K, V]) PutAll(other Map[K, V]) {
+	for key, value := range other.All() {
+		t.Put(key, value)
+	}
+}
+
+// ContainsKey reports whether the key is in the map
+func (t *TreeMap[K, V]) ContainsKey(key K) bool {
+	return t.findNode(key) != nil
+}
+
+// ContainsValue reports whether any key is associated with a value equal to value according to eq
+func (t *TreeMap[K, V]) ContainsValue(value V, eq func(a, b V) bool) bool {
+	return containsValue(t.Values(), value, eq)
+}
+
 // Remove removes a key-value pair
 func (t *TreeMap[K, V]) Remove(key K) error {
 	node := t.findNode(key)
@@ -61,8 +92,7 @@ func (t *TreeMap[K, V]) Remove(key K) error {
 		return errors.New("key not found")
 	}
 
-	t.delete(node)
-	t.size--
+	t.removeNode(node)
 	return nil
 }
 
@@ -71,26 +101,65 @@ func (t *TreeMap[K, V]) Size() int64 {
 	return t.size
 }
 
+// IsEmpty reports whether the map has no key-value pairs
+func (t *TreeMap[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Clear removes every key-value pair
+func (t *TreeMap[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+	t.modCount++
+}
+
 // NewIterator returns a new iterator for in-order traversal
 func (t *TreeMap[K, V]) NewIterator() collections.Iterator[Entry[K, V]] {
-	var firstNode *rbNode[K, V]
-	if t.root != nil {
-		firstNode = t.root.getMinimum()
-	}
+	return NewTreeMapIterator(t)
+}
+
+// NewMutableIterator returns a new in-order iterator that can also remove entries
+func (t *TreeMap[K, V]) NewMutableIterator() collections.MutableIterator[Entry[K, V]] {
+	return NewTreeMapIterator(t)
+}
 
-	return &TreeMapIterator[K, V]{
-		tree:    t,
-		current: firstNode,
+// All returns an iter.Seq2 over the key-value pairs in ascending key order
+func (t *TreeMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if t.root == nil {
+			return
+		}
+		for node := t.root.getMinimum(); node != nil; node = node.getSuccessor() {
+			if !yield(node.Node.Item.Key(), node.Node.Item.Value()) {
+				return
+			}
+		}
 	}
 }
 
+// Keys returns an iter.Seq over the keys in ascending order
+func (t *TreeMap[K, V]) Keys() iter.Seq[K] {
+	return keysOf(t.All())
+}
+
+// Values returns an iter.Seq over the values in ascending key order
+func (t *TreeMap[K, V]) Values() iter.Seq[V] {
+	return valuesOf(t.All())
+}
+
+// Entries returns an iter.Seq over the key-value pairs in ascending key order
+func (t *TreeMap[K, V]) Entries() iter.Seq[Entry[K, V]] {
+	return entriesOf(t.All())
+}
+
 // findNode locates a node with the given key
 func (t *TreeMap[K, V]) findNode(key K) *rbNode[K, V] {
 	current := t.root
 	for current != nil {
-		if t.less(key, current.Node.Item.Key()) {
+		order := t.compare(key, current.Node.Item.Key())
+		if order < 0 {
 			current = current.left
-		} else if t.less(current.Node.Item.Key(), key) {
+		} else if order > 0 {
 			current = current.right
 		} else {
 			return current
@@ -104,18 +173,21 @@ func (t *TreeMap[K, V]) insert(node *rbNode[K, V]) {
 	if t.root == nil {
 		t.root = node
 		t.size++
+		t.modCount++
 		t.insertFixup(node)
 		return
 	}
 
 	var parent *rbNode[K, V]
+	var order int
 	current := t.root
 	// Find insertion point
 	for {
 		parent = current
-		if t.less(node.Node.Item.Key(), current.Node.Item.Key()) {
+		order = t.compare(node.Node.Item.Key(), current.Node.Item.Key())
+		if order < 0 {
 			current = current.left
-		} else if t.less(current.Node.Item.Key(), node.Node.Item.Key()) {
+		} else if order > 0 {
 			current = current.right
 		} else {
 			// Key exists, update value
@@ -131,13 +203,17 @@ func (t *TreeMap[K, V]) insert(node *rbNode[K, V]) {
 	// At this point, parent is guaranteed to be non-nil
 	// because we handled the empty tree case earlier
 	node.parent = parent
-	if t.less(node.Node.Item.Key(), parent.Node.Item.Key()) {
+	if order < 0 {
 		parent.left = node
 	} else {
 		parent.right = node
 	}
+	for ancestor := parent; ancestor != nil; ancestor = ancestor.parent {
+		ancestor.count++
+	}
 
 	t.size++
+	t.modCount++
 	t.insertFixup(node)
 }
 
@@ -199,6 +275,9 @@ func (t *TreeMap[K, V]) rotateLeft(node *rbNode[K, V]) {
 
 	right.left = node
 	node.parent = right
+
+	node.updateCount()
+	right.updateCount()
 }
 
 func (t *TreeMap[K, V]) rotateRight(node *rbNode[K, V]) {
@@ -219,6 +298,16 @@ func (t *TreeMap[K, V]) rotateRight(node *rbNode[K, V]) {
 
 	left.right = node
 	node.parent = left
+
+	node.updateCount()
+	left.updateCount()
+}
+
+// removeNode deletes node from the tree and records the structural modification
+func (t *TreeMap[K, V]) removeNode(node *rbNode[K, V]) {
+	t.delete(node)
+	t.size--
+	t.modCount++
 }
 
 func (t *TreeMap[K, V]) delete(node *rbNode[K, V]) {
@@ -247,6 +336,9 @@ func (t *TreeMap[K, V]) delete(node *rbNode[K, V]) {
 	} else {
 		successor.parent.right = child
 	}
+	for ancestor := successor.parent; ancestor != nil; ancestor = ancestor.parent {
+		ancestor.count--
+	}
 
 	if successor != node {
 		node.Node.Item = successor.Node.Item
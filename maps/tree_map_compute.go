@@ -0,0 +1,64 @@
+package maps
+
+// Ensure TreeMap implements computeOps
+var _ computeOps[string, int] = (*TreeMap[string, int])(nil)
+
+// PutIfAbsent associates value with key unless the key is already present, returning the value
+// associated with key afterwards and whether it was already present
+func (t *TreeMap[K, V]) PutIfAbsent(key K, value V) (V, bool) {
+	return putIfAbsent[K, V](t, key, value)
+}
+
+// ComputeIfAbsent associates the result of mapping with key unless the key is already present,
+// returning the value associated with key afterwards. mapping must not modify the map
+func (t *TreeMap[K, V]) ComputeIfAbsent(key K, mapping func(key K) V) V {
+	return computeIfAbsent[K, V](t, key, mapping)
+}
+
+// ComputeIfPresent replaces the value associated with key, if any, with the result of remapping,
+// removing the key when remapping returns false. remapping must not modify the map
+func (t *TreeMap[K, V]) ComputeIfPresent(key K, remapping func(key K, value V) (V, bool)) (V, bool) {
+	return computeIfPresent[K, V](t, key, remapping)
+}
+
+// Compute replaces the value associated with key with the result of remapping, which also learns whether
+// the key was present, removing the key when remapping returns false. remapping must not modify the map
+func (t *TreeMap[K, V]) Compute(key K, remapping func(key K, value V, present bool) (V, bool)) (V, bool) {
+	return compute[K, V](t, key, remapping)
+}
+
+// Merge associates value with key if the key is absent, otherwise combines both values with merging,
+// removing the key when merging returns false. merging must not modify the map
+func (t *TreeMap[K, V]) Merge(key K, value V, merging func(old, value V) (V, bool)) (V, bool) {
+	return merge[K, V](t, key, value, merging)
+}
+
+// Replace associates newValue with key only if it is currently associated with a value equal to oldValue according to eq
+func (t *TreeMap[K, V]) Replace(key K, oldValue, newValue V, eq func(a, b V) bool) bool {
+	return replace[K, V](t, key, oldValue, newValue, eq)
+}
+
+// RemoveIf removes key only if it is currently associated with a value equal to expected according to eq
+func (t *TreeMap[K, V]) RemoveIf(key K, expected V, eq func(a, b V) bool) bool {
+	return removeIfEqual[K, V](t, key, expected, eq)
+}
+
+// lookup returns the value associated with key and whether the key is present
+func (t *TreeMap[K, V]) lookup(key K) (V, bool) {
+	if node := t.findNode(key); node != nil {
+		return node.Node.Item.Value(), true
+	}
+	var zero V
+	return zero, false
+}
+
+// store inserts or updates a key-value pair, which the map always accepts
+func (t *TreeMap[K, V]) store(key K, value V) bool {
+	t.Put(key, value)
+	return true
+}
+
+// remove removes key, which must be present
+func (t *TreeMap[K, V]) remove(key K) {
+	t.removeNode(t.findNode(key))
+}
@@ -1,41 +1,103 @@
 package maps
 
 import (
-	"errors"
+	"github.com/jorge-barroso/collections"
 )
 
-// TreeMapIterator implements in-order traversal
+// TreeMapIterator implements in-order traversal, ascending or descending, optionally stopping at the end of a key range
 type TreeMapIterator[K comparable, V any] struct {
-	tree    *TreeMap[K, V]
-	current *rbNode[K, V]
+	tree             *TreeMap[K, V]
+	current          *rbNode[K, V] // Node the iterator is positioned on
+	next             *rbNode[K, V] // Node the next call to Next moves to
+	bounds           keyRange[K]   // Keys the iteration is limited to
+	descending       bool          // Whether the iterator moves to predecessors rather than successors
+	expectedModCount int           // modCount of the tree when the iterator last synchronised with it
+	failed           bool          // Set once a concurrent modification has been reported by Next
 }
 
+// Ensure TreeMapIterator implements MutableIterator
+var _ collections.MutableIterator[Entry[string, int]] = (*TreeMapIterator[string, int])(nil)
+
 // NewTreeMapIterator creates a new iterator starting at the leftmost node
 func NewTreeMapIterator[K comparable, V any](tree *TreeMap[K, V]) *TreeMapIterator[K, V] {
-	var firstNode *rbNode[K, V]
-	if tree.root != nil {
-		firstNode = tree.root.getMinimum()
-	}
+	return newTreeMapIterator(tree, tree.firstNode(), keyRange[K]{}, false)
+}
 
+// newTreeMapIterator creates a new iterator starting at first and walking in the given direction
+// for as long as the keys stay within bounds
+func newTreeMapIterator[K comparable, V any](tree *TreeMap[K, V], first *rbNode[K, V], bounds keyRange[K], descending bool) *TreeMapIterator[K, V] {
 	return &TreeMapIterator[K, V]{
-		tree:    tree,
-		current: firstNode,
+		tree:             tree,
+		next:             first,
+		bounds:           bounds,
+		descending:       descending,
+		expectedModCount: tree.modCount,
 	}
 }
 
-// Next checks if there are more elements
+// Next advances the iterator to the in-order successor, or predecessor when descending
 func (it *TreeMapIterator[K, V]) Next() bool {
-	return it.current != nil
+	// The successor pointers may be stale once the tree has been restructured
+	if it.modified() {
+		if it.failed {
+			return false
+		}
+		it.failed = true
+		return true
+	}
+
+	if it.next == nil || !it.bounds.contains(it.next.Node.Item.Key(), it.tree.compare) {
+		return false
+	}
+
+	it.current = it.next
+	if it.descending {
+		it.next = it.current.getPredecessor()
+	} else {
+		it.next = it.current.getSuccessor()
+	}
+	return true
 }
 
-// Value returns the current element and advances the iterator
+// Value returns the element the iterator is positioned on
 func (it *TreeMapIterator[K, V]) Value() (Entry[K, V], error) {
+	var zero Entry[K, V]
+	if err := it.checkPositioned(); err != nil {
+		return zero, err
+	}
+
+	return it.current.Node.Item, nil
+}
+
+// Remove deletes the entry the iterator is positioned on in O(log n)
+func (it *TreeMapIterator[K, V]) Remove() error {
+	if err := it.checkPositioned(); err != nil {
+		return err
+	}
+
+	// Deleting a node with two children moves its successor's entry into it,
+	// so that same node is where an ascending iteration has to resume
+	if !it.descending && it.current.left != nil && it.current.right != nil {
+		it.next = it.current
+	}
+	it.tree.removeNode(it.current)
+	it.current = nil
+	it.expectedModCount = it.tree.modCount
+	return nil
+}
+
+// checkPositioned returns an error unless the iterator sits on a valid, unmodified node
+func (it *TreeMapIterator[K, V]) checkPositioned() error {
+	if it.modified() {
+		return collections.ErrConcurrentModification
+	}
 	if it.current == nil {
-		var zero Entry[K, V]
-		return zero, errors.New("no more elements")
+		return collections.ErrNoSuchElement
 	}
+	return nil
+}
 
-	value := it.current.Node.Item
-	it.current = it.current.getSuccessor()
-	return value, nil
+// modified reports whether the tree has been structurally modified behind the iterator
+func (it *TreeMapIterator[K, V]) modified() bool {
+	return it.tree.modCount != it.expectedModCount
 }
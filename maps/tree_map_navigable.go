@@ -0,0 +1,206 @@
+package maps
+
+import (
+	"iter"
+
+	"github.com/jorge-barroso/collections"
+)
+
+// FirstKey returns the smallest key of the map
+func (t *TreeMap[K, V]) FirstKey() (K, error) {
+	entry, err := t.FirstEntry()
+	return entry.key, err
+}
+
+// LastKey returns the largest key of the map
+func (t *TreeMap[K, V]) LastKey() (K, error) {
+	entry, err := t.LastEntry()
+	return entry.key, err
+}
+
+// FirstEntry returns the key-value pair with the smallest key
+func (t *TreeMap[K, V]) FirstEntry() (Entry[K, V], error) {
+	return entryOrEmpty(t.firstNode())
+}
+
+// LastEntry returns the key-value pair with the largest key
+func (t *TreeMap[K, V]) LastEntry() (Entry[K, V], error) {
+	return entryOrEmpty(t.lastNode())
+}
+
+// PollFirst removes and returns the key-value pair with the smallest key
+func (t *TreeMap[K, V]) PollFirst() (Entry[K, V], error) {
+	return t.poll(t.firstNode())
+}
+
+// PollLast removes and returns the key-value pair with the largest key
+func (t *TreeMap[K, V]) PollLast() (Entry[K, V], error) {
+	return t.poll(t.lastNode())
+}
+
+// FloorEntry returns the key-value pair with the largest key less than or equal to key, if any
+func (t *TreeMap[K, V]) FloorEntry(key K) (Entry[K, V], bool) {
+	return entryIfAny(t.floorNode(key))
+}
+
+// CeilingEntry returns the key-value pair with the smallest key greater than or equal to key, if any
+func (t *TreeMap[K, V]) CeilingEntry(key K) (Entry[K, V], bool) {
+	return entryIfAny(t.ceilingNode(key))
+}
+
+// LowerEntry returns the key-value pair with the largest key strictly less than key, if any
+func (t *TreeMap[K, V]) LowerEntry(key K) (Entry[K, V], bool) {
+	return entryIfAny(t.lowerNode(key))
+}
+
+// HigherEntry returns the key-value pair with the smallest key strictly greater than key, if any
+func (t *TreeMap[K, V]) HigherEntry(key K) (Entry[K, V], bool) {
+	return entryIfAny(t.higherNode(key))
+}
+
+// NewDescendingIterator returns a new iterator visiting the entries in descending key order, which can also remove them
+func (t *TreeMap[K, V]) NewDescendingIterator() collections.MutableIterator[Entry[K, V]] {
+	return newTreeMapIterator(t, t.lastNode(), keyRange[K]{}, true)
+}
+
+// Backward returns an iter.Seq2 over the key-value pairs in descending key order
+func (t *TreeMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for node := t.lastNode(); node != nil; node = node.getPredecessor() {
+			if !yield(node.Node.Item.Key(), node.Node.Item.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Range returns an iter.Seq2 over the key-value pairs with keys between from and to in ascending order,
+// each end being included or not as requested. The first pair is found in O(log n).
+func (t *TreeMap[K, V]) Range(from K, fromInclusive bool, to K, toInclusive bool) iter.Seq2[K, V] {
+	bounds := keyRange[K]{
+		from: from, hasFrom: true, fromInclusive: fromInclusive,
+		to: to, hasTo: true, toInclusive: toInclusive,
+	}
+	return func(yield func(K, V) bool) {
+		for node := t.lowestNode(bounds); node != nil; node = node.getSuccessor() {
+			if bounds.tooHigh(node.Node.Item.Key(), t.compare) || !yield(node.Node.Item.Key(), node.Node.Item.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// NewRangeIterator returns a new iterator over the entries with keys between from and to in ascending order,
+// each end being included or not as requested, which can also remove them
+func (t *TreeMap[K, V]) NewRangeIterator(from K, fromInclusive bool, to K, toInclusive bool) collections.MutableIterator[Entry[K, V]] {
+	bounds := keyRange[K]{
+		from: from, hasFrom: true, fromInclusive: fromInclusive,
+		to: to, hasTo: true, toInclusive: toInclusive,
+	}
+	return newTreeMapIterator(t, t.lowestNode(bounds), bounds, false)
+}
+
+// poll removes node, if any, and returns its entry
+func (t *TreeMap[K, V]) poll(node *rbNode[K, V]) (Entry[K, V], error) {
+	entry, err := entryOrEmpty(node)
+	if err == nil {
+		t.removeNode(node)
+	}
+	return entry, err
+}
+
+// firstNode returns the node with the smallest key, or nil if the map is empty
+func (t *TreeMap[K, V]) firstNode() *rbNode[K, V] {
+	if t.root == nil {
+		return nil
+	}
+	return t.root.getMinimum()
+}
+
+// lastNode returns the node with the largest key, or nil if the map is empty
+func (t *TreeMap[K, V]) lastNode() *rbNode[K, V] {
+	if t.root == nil {
+		return nil
+	}
+	return t.root.getMaximum()
+}
+
+// floorNode returns the node with the largest key less than or equal to key, or nil if there is none
+func (t *TreeMap[K, V]) floorNode(key K) *rbNode[K, V] {
+	var candidate *rbNode[K, V]
+	for current := t.root; current != nil; {
+		order := t.compare(key, current.Node.Item.Key())
+		if order < 0 {
+			current = current.left
+		} else if order > 0 {
+			candidate = current
+			current = current.right
+		} else {
+			return current
+		}
+	}
+	return candidate
+}
+
+// ceilingNode returns the node with the smallest key greater than or equal to key, or nil if there is none
+func (t *TreeMap[K, V]) ceilingNode(key K) *rbNode[K, V] {
+	var candidate *rbNode[K, V]
+	for current := t.root; current != nil; {
+		order := t.compare(key, current.Node.Item.Key())
+		if order < 0 {
+			candidate = current
+			current = current.left
+		} else if order > 0 {
+			current = current.right
+		} else {
+			return current
+		}
+	}
+	return candidate
+}
+
+// lowerNode returns the node with the largest key strictly less than key, or nil if there is none
+func (t *TreeMap[K, V]) lowerNode(key K) *rbNode[K, V] {
+	var candidate *rbNode[K, V]
+	for current := t.root; current != nil; {
+		if t.compare(current.Node.Item.Key(), key) < 0 {
+			candidate = current
+			current = current.right
+		} else {
+			current = current.left
+		}
+	}
+	return candidate
+}
+
+// higherNode returns the node with the smallest key strictly greater than key, or nil if there is none
+func (t *TreeMap[K, V]) higherNode(key K) *rbNode[K, V] {
+	var candidate *rbNode[K, V]
+	for current := t.root; current != nil; {
+		if t.compare(key, current.Node.Item.Key()) < 0 {
+			candidate = current
+			current = current.left
+		} else {
+			current = current.right
+		}
+	}
+	return candidate
+}
+
+// entryOrEmpty returns the entry of node, or errMapEmpty if there is no node
+func entryOrEmpty[K comparable, V any](node *rbNode[K, V]) (Entry[K, V], error) {
+	if node == nil {
+		var zero Entry[K, V]
+		return zero, errMapEmpty
+	}
+	return node.Node.Item, nil
+}
+
+// entryIfAny returns the entry of node and whether there is a node
+func entryIfAny[K comparable, V any](node *rbNode[K, V]) (Entry[K, V], bool) {
+	if node == nil {
+		var zero Entry[K, V]
+		return zero, false
+	}
+	return node.Node.Item, true
+}
@@ -0,0 +1,174 @@
+package maps
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/jorge-barroso/collections"
+	"github.com/jorge-barroso/collections/collectionstest"
+	"github.com/stretchr/testify/assert"
+)
+
+// newIntTreeMap creates a TreeMap of int keys in ascending order holding the given keys, each mapped to itself
+func newIntTreeMap(keys ...int) *TreeMap[int, int] {
+	tm := NewTreeMap[int, int](func(a, b int) bool { return a < b })
+	for _, key := range keys {
+		tm.Put(key, key)
+	}
+	return tm
+}
+
+func TestTreeMap_FirstAndLast(t *testing.T) {
+	tm := newIntTreeMap()
+	_, err := tm.FirstKey()
+	assert.Error(t, err, "Expected an error for the first key of an empty map")
+	_, err = tm.LastEntry()
+	assert.Error(t, err, "Expected an error for the last entry of an empty map")
+	_, err = tm.PollFirst()
+	assert.Error(t, err, "Expected an error when polling an empty map")
+
+	tm = newIntTreeMap(5, 1, 9, 3)
+	first, err := tm.FirstKey()
+	assert.NoError(t, err, "Unexpected error for the first key")
+	assert.Equal(t, 1, first, "Unexpected first key")
+	last, err := tm.LastKey()
+	assert.NoError(t, err, "Unexpected error for the last key")
+	assert.Equal(t, 9, last, "Unexpected last key")
+
+	polled, err := tm.PollFirst()
+	assert.NoError(t, err, "Unexpected error when polling the first entry")
+	assert.Equal(t, 1, polled.Key(), "PollFirst should return the smallest key")
+	polled, err = tm.PollLast()
+	assert.NoError(t, err, "Unexpected error when polling the last entry")
+	assert.Equal(t, 9, polled.Key(), "PollLast should return the largest key")
+	assert.Equal(t, []int{3, 5}, slices.Collect(tm.Keys()), "Polled entries should be removed")
+}
+
+func TestTreeMap_FloorCeilingLowerHigher(t *testing.T) {
+	keys := rand.Perm(200)[:100]
+	tm := newIntTreeMap(keys...)
+	slices.Sort(keys)
+
+	for probe := -1; probe <= 200; probe++ {
+		// Position of the first key greater than or equal to the probe
+		i, found := slices.BinarySearch(keys, probe)
+		floor, higher := i-1, i
+		if found {
+			floor, higher = i, i+1
+		}
+
+		lookups := []struct {
+			name   string
+			lookup func(key int) (Entry[int, int], bool)
+			index  int
+		}{
+			{"FloorEntry", tm.FloorEntry, floor},
+			{"CeilingEntry", tm.CeilingEntry, i},
+			{"LowerEntry", tm.LowerEntry, i - 1},
+			{"HigherEntry", tm.HigherEntry, higher},
+		}
+		for _, l := range lookups {
+			entry, ok := l.lookup(probe)
+			exists := l.index >= 0 && l.index < len(keys)
+			assert.Equal(t, exists, ok, "Unexpected result of %s(%d)", l.name, probe)
+			if exists && ok {
+				assert.Equal(t, keys[l.index], entry.Key(), "Unexpected key for %s(%d)", l.name, probe)
+			}
+		}
+	}
+}
+
+func TestTreeMap_Backward(t *testing.T) {
+	tm := newIntTreeMap(4, 2, 8, 6)
+	assert.Equal(t, []int{8, 6, 4, 2}, slices.Collect(keysOf(tm.Backward())), "Backward should visit keys in descending order")
+
+	var visited []int
+	for key := range tm.Backward() {
+		visited = append(visited, key)
+		if key == 6 {
+			break
+		}
+	}
+	assert.Equal(t, []int{8, 6}, visited, "Backward should stop when the loop breaks")
+}
+
+func TestTreeMap_DescendingIterator(t *testing.T) {
+	tm := newIntTreeMap(1, 2, 3, 4, 5, 6, 7)
+	it := tm.NewDescendingIterator()
+	var visited []int
+	for it.Next() {
+		entry, err := it.Value()
+		assert.NoError(t, err, "Unexpected error during iteration")
+		visited = append(visited, entry.Key())
+		if entry.Key()%2 == 0 {
+			assert.NoError(t, it.Remove(), "Unexpected error when removing through the iterator")
+		}
+	}
+	assert.Equal(t, []int{7, 6, 5, 4, 3, 2, 1}, visited, "The iterator should visit keys in descending order")
+	assert.Equal(t, []int{1, 3, 5, 7}, slices.Collect(tm.Keys()), "Keys mismatch after removing through the iterator")
+
+	tm.Put(10, 10)
+	_, err := it.Value()
+	assert.ErrorIs(t, err, collections.ErrConcurrentModification, "Expected the insertion to be detected")
+}
+
+func TestTreeMap_Range(t *testing.T) {
+	tm := newIntTreeMap(10, 20, 30, 40, 50)
+	cases := []struct {
+		from, to                   int
+		fromInclusive, toInclusive bool
+		want                       []int
+	}{
+		{20, 40, true, true, []int{20, 30, 40}},
+		{20, 40, false, false, []int{30}},
+		{20, 40, true, false, []int{20, 30}},
+		{15, 45, false, true, []int{20, 30, 40}},
+		{0, 100, true, true, []int{10, 20, 30, 40, 50}},
+		{30, 30, true, true, []int{30}},
+		{30, 30, true, false, nil},
+		{40, 20, true, true, nil},
+		{51, 60, true, true, nil},
+	}
+
+	for _, c := range cases {
+		got := slices.Collect(keysOf(tm.Range(c.from, c.fromInclusive, c.to, c.toInclusive)))
+		assert.Equal(t, c.want, got, "Unexpected keys in range %d (%t) to %d (%t)", c.from, c.fromInclusive, c.to, c.toInclusive)
+
+		var iterated []int
+		for it := tm.NewRangeIterator(c.from, c.fromInclusive, c.to, c.toInclusive); it.Next(); {
+			entry, err := it.Value()
+			assert.NoError(t, err, "Unexpected error during iteration")
+			iterated = append(iterated, entry.Key())
+		}
+		assert.Equal(t, c.want, iterated, "The range iterator should agree with Range")
+	}
+}
+
+func TestTreeMap_RangeIteratorConformance(t *testing.T) {
+	tm := newIntTreeMap(1, 2, 3, 4, 5)
+	collectionstest.TestIterable[Entry[int, int]](t, rangeIterable{tm}, []Entry[int, int]{
+		{key: 2, value: 2},
+		{key: 3, value: 3},
+		{key: 4, value: 4},
+	})
+}
+
+// rangeIterable exposes the range [2, 4] of a TreeMap as an Iterable
+type rangeIterable struct {
+	tm *TreeMap[int, int]
+}
+
+// NewIterator returns an iterator over the range
+func (r rangeIterable) NewIterator() collections.Iterator[Entry[int, int]] {
+	return r.tm.NewRangeIterator(2, true, 4, true)
+}
+
+func TestTreeMap_RangeIteratorRemove(t *testing.T) {
+	tm := newIntTreeMap(1, 2, 3, 4, 5, 6)
+	it := tm.NewRangeIterator(2, true, 5, false)
+	for it.Next() {
+		assert.NoError(t, it.Remove(), "Unexpected error when removing through the iterator")
+	}
+	assert.Equal(t, []int{1, 5, 6}, slices.Collect(tm.Keys()), "Only the keys in range should be removed")
+}
@@ -0,0 +1,69 @@
+package maps
+
+import "github.com/jorge-barroso/collections"
+
+// keyRange bounds the keys of a TreeMap, each end can be open, inclusive or exclusive
+type keyRange[K any] struct {
+	from, to                   K
+	hasFrom, hasTo             bool
+	fromInclusive, toInclusive bool
+}
+
+// tooLow reports whether key falls below the lower end of the range
+func (r keyRange[K]) tooLow(key K, compare collections.Comparator[K]) bool {
+	if !r.hasFrom {
+		return false
+	}
+	order := compare(key, r.from)
+	return order < 0 || (order == 0 && !r.fromInclusive)
+}
+
+// tooHigh reports whether key falls above the upper end of the range
+func (r keyRange[K]) tooHigh(key K, compare collections.Comparator[K]) bool {
+	if !r.hasTo {
+		return false
+	}
+	order := compare(key, r.to)
+	return order > 0 || (order == 0 && !r.toInclusive)
+}
+
+// contains reports whether key falls within both ends of the range
+func (r keyRange[K]) contains(key K, compare collections.Comparator[K]) bool {
+	return !r.tooLow(key, compare) && !r.tooHigh(key, compare)
+}
+
+// lowestNode returns the node with the smallest key within the range, or nil if there is none
+func (t *TreeMap[K, V]) lowestNode(r keyRange[K]) *rbNode[K, V] {
+	var node *rbNode[K, V]
+	switch {
+	case !r.hasFrom:
+		node = t.firstNode()
+	case r.fromInclusive:
+		node = t.ceilingNode(r.from)
+	default:
+		node = t.higherNode(r.from)
+	}
+
+	if node == nil || r.tooHigh(node.Node.Item.Key(), t.compare) {
+		return nil
+	}
+	return node
+}
+
+// highestNode returns the node with the largest key within the range, or nil if there is none
+func (t *TreeMap[K, V]) highestNode(r keyRange[K]) *rbNode[K, V] {
+	var node *rbNode[K, V]
+	switch {
+	case !r.hasTo:
+		node = t.lastNode()
+	case r.toInclusive:
+		node = t.floorNode(r.to)
+	default:
+		node = t.lowerNode(r.to)
+	}
+
+	if node == nil || r.tooLow(node.Node.Item.Key(), t.compare) {
+		return nil
+	}
+	return node
+}
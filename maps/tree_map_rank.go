@@ -0,0 +1,90 @@
+package maps
+
+import "fmt"
+
+// Rank returns the number of keys strictly less than key, which is the index key has or would have
+// in ascending order. It runs in O(log n).
+func (t *TreeMap[K, V]) Rank(key K) int64 {
+	return t.countBelow(keyRange[K]{from: key, hasFrom: true, fromInclusive: true})
+}
+
+// Select returns the key-value pair at index in ascending key order, counting from 0, in O(log n)
+func (t *TreeMap[K, V]) Select(index int64) (Entry[K, V], error) {
+	node, err := t.nodeAt(index)
+	if err != nil {
+		return Entry[K, V]{}, err
+	}
+	return node.Node.Item, nil
+}
+
+// RemoveAt removes and returns the key-value pair at index in ascending key order, counting from 0, in O(log n)
+func (t *TreeMap[K, V]) RemoveAt(index int64) (Entry[K, V], error) {
+	node, err := t.nodeAt(index)
+	if err != nil {
+		return Entry[K, V]{}, err
+	}
+	entry := node.Node.Item
+	t.removeNode(node)
+	return entry, nil
+}
+
+// CountRange returns the number of keys greater than or equal to lo and strictly less than hi in O(log n),
+// or 0 if hi is not greater than lo
+func (t *TreeMap[K, V]) CountRange(lo, hi K) int64 {
+	return t.countWithin(keyRange[K]{from: lo, to: hi, hasFrom: true, hasTo: true, fromInclusive: true})
+}
+
+// nodeAt returns the node at index in ascending key order, walking down the subtree sizes
+func (t *TreeMap[K, V]) nodeAt(index int64) (*rbNode[K, V], error) {
+	if index < 0 || index >= t.size {
+		return nil, fmt.Errorf("index out of bounds, must be between 0 and %d, but %d was provided", t.size-1, index)
+	}
+
+	current := t.root
+	for {
+		leftSize := current.left.subtreeSize()
+		switch {
+		case index < leftSize:
+			current = current.left
+		case index > leftSize:
+			index -= leftSize + 1
+			current = current.right
+		default:
+			return current, nil
+		}
+	}
+}
+
+// countWithin returns the number of keys within both ends of the range
+func (t *TreeMap[K, V]) countWithin(r keyRange[K]) int64 {
+	// Reversed ends make the keys below and above the range overlap
+	return max(t.size-t.countBelow(r)-t.countAbove(r), 0)
+}
+
+// countBelow returns the number of keys below the lower end of the range
+func (t *TreeMap[K, V]) countBelow(r keyRange[K]) int64 {
+	var count int64
+	for current := t.root; current != nil; {
+		if r.tooLow(current.Node.Item.Key(), t.compare) {
+			count += current.left.subtreeSize() + 1
+			current = current.right
+		} else {
+			current = current.left
+		}
+	}
+	return count
+}
+
+// countAbove returns the number of keys above the upper end of the range
+func (t *TreeMap[K, V]) countAbove(r keyRange[K]) int64 {
+	var count int64
+	for current := t.root; current != nil; {
+		if r.tooHigh(current.Node.Item.Key(), t.compare) {
+			count += current.right.subtreeSize() + 1
+			current = current.left
+		} else {
+			current = current.right
+		}
+	}
+	return count
+}
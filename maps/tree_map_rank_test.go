@@ -0,0 +1,116 @@
+package maps
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// subtreeSizesMatch reports whether every node of the subtree rooted at node records the number of nodes below it
+func subtreeSizesMatch[K comparable, V any](node *rbNode[K, V]) bool {
+	if node == nil {
+		return true
+	}
+	return node.count == node.left.subtreeSize()+node.right.subtreeSize()+1 &&
+		subtreeSizesMatch(node.left) && subtreeSizesMatch(node.right)
+}
+
+func TestTreeMap_Rank(t *testing.T) {
+	tm := newIntTreeMap(10, 20, 30, 40)
+
+	assert.Equal(t, int64(0), tm.Rank(5), "No key is below 5")
+	assert.Equal(t, int64(0), tm.Rank(10), "The smallest key should have rank 0")
+	assert.Equal(t, int64(2), tm.Rank(30), "Expected 2 keys below 30")
+	assert.Equal(t, int64(3), tm.Rank(35), "Absent keys should be ranked where they would be inserted")
+	assert.Equal(t, int64(4), tm.Rank(50), "Every key is below 50")
+	assert.Equal(t, int64(0), newIntTreeMap().Rank(1), "An empty map should rank every key 0")
+}
+
+func TestTreeMap_Select(t *testing.T) {
+	tm := newIntTreeMap(30, 10, 40, 20)
+
+	for i, key := range []int{10, 20, 30, 40} {
+		entry, err := tm.Select(int64(i))
+		assert.NoError(t, err, "Unexpected error when selecting index %d", i)
+		assert.Equal(t, key, entry.Key(), "Unexpected key at index %d", i)
+	}
+
+	_, err := tm.Select(-1)
+	assert.Error(t, err, "A negative index should be rejected")
+	_, err = tm.Select(4)
+	assert.Error(t, err, "An index past the last entry should be rejected")
+}
+
+func TestTreeMap_RemoveAt(t *testing.T) {
+	tm := newIntTreeMap(10, 20, 30, 40)
+
+	entry, err := tm.RemoveAt(1)
+	assert.NoError(t, err, "Unexpected error when removing index 1")
+	assert.Equal(t, 20, entry.Key(), "Expected the removed entry to be the second smallest")
+	assert.Equal(t, []int{10, 30, 40}, slices.Collect(tm.Keys()), "Unexpected keys after RemoveAt")
+	assert.Equal(t, int64(3), tm.Size(), "Expected size to shrink after RemoveAt")
+
+	_, err = tm.RemoveAt(3)
+	assert.Error(t, err, "An index past the last entry should be rejected")
+	assert.Equal(t, int64(3), tm.Size(), "A rejected index should not remove anything")
+}
+
+func TestTreeMap_CountRange(t *testing.T) {
+	tm := newIntTreeMap(10, 20, 30, 40, 50)
+
+	assert.Equal(t, int64(2), tm.CountRange(20, 40), "The range should include lo and exclude hi")
+	assert.Equal(t, int64(3), tm.CountRange(15, 45), "Ends need not be keys of the map")
+	assert.Equal(t, int64(5), tm.CountRange(0, 100), "Every key should be within a wider range")
+	assert.Equal(t, int64(0), tm.CountRange(30, 30), "A range with equal ends should be empty")
+	assert.Equal(t, int64(0), tm.CountRange(40, 20), "A reversed range should be empty")
+}
+
+func TestTreeMap_OrderStatisticsAfterRandomChanges(t *testing.T) {
+	random := rand.New(rand.NewSource(23))
+	tm := newIntTreeMap()
+	var keys []int
+
+	for range 2000 {
+		key := random.Intn(500)
+		if random.Intn(3) == 0 {
+			if index, found := slices.BinarySearch(keys, key); found {
+				keys = slices.Delete(keys, index, index+1)
+			}
+			_ = tm.Remove(key)
+		} else {
+			if index, found := slices.BinarySearch(keys, key); !found {
+				keys = slices.Insert(keys, index, key)
+			}
+			tm.Put(key, key)
+		}
+	}
+
+	assert.True(t, subtreeSizesMatch(tm.root), "Every node should record the size of its subtree")
+	assert.Equal(t, int64(len(keys)), tm.root.subtreeSize(), "The root should record the size of the map")
+	for i, key := range keys {
+		assert.Equal(t, int64(i), tm.Rank(key), "Unexpected rank of key %d", key)
+		entry, err := tm.Select(int64(i))
+		assert.NoError(t, err, "Unexpected error when selecting index %d", i)
+		assert.Equal(t, key, entry.Key(), "Unexpected key at index %d", i)
+	}
+
+	lo, hi := 100, 300
+	expected := 0
+	for _, key := range keys {
+		if key >= lo && key < hi {
+			expected++
+		}
+	}
+	assert.Equal(t, int64(expected), tm.CountRange(lo, hi), "Unexpected number of keys between %d and %d", lo, hi)
+
+	for tm.Size() > 0 {
+		index := random.Int63n(tm.Size())
+		entry, err := tm.RemoveAt(index)
+		assert.NoError(t, err, "Unexpected error when removing index %d", index)
+		assert.Equal(t, keys[index], entry.Key(), "Unexpected key removed at index %d", index)
+		keys = slices.Delete(keys, int(index), int(index)+1)
+		assert.True(t, subtreeSizesMatch(tm.root), "Subtree sizes should be kept through RemoveAt")
+	}
+}
@@ -0,0 +1,80 @@
+package maps
+
+import "github.com/jorge-barroso/collections"
+
+// treeMapSpliterator traverses the in-order range [current, fence) of a TreeMap.
+// The range always ends right after the subtree rooted at splitNode, so splitting around
+// that node hands its left subtree to the new spliterator and keeps the node and its right
+// subtree, halving the remaining work without walking the tree.
+type treeMapSpliterator[K comparable, V any] struct {
+	tree      *TreeMap[K, V]
+	current   *rbNode[K, V] // Next node to visit
+	fence     *rbNode[K, V] // First node past the range, nil for the end of the tree
+	splitNode *rbNode[K, V] // Root of the subtree the range will be split around
+	estimate  int
+}
+
+// Spliterator returns a splittable in-order traversal over the entries of the map.
+// The map must not be structurally modified while the spliterator is in use.
+func (t *TreeMap[K, V]) Spliterator() collections.Spliterator[Entry[K, V]] {
+	var first *rbNode[K, V]
+	if t.root != nil {
+		first = t.root.getMinimum()
+	}
+
+	return &treeMapSpliterator[K, V]{
+		tree:      t,
+		current:   first,
+		splitNode: t.root,
+		estimate:  int(t.size),
+	}
+}
+
+// TryAdvance calls action on the next entry of the range
+func (s *treeMapSpliterator[K, V]) TryAdvance(action func(Entry[K, V])) bool {
+	if s.current == nil || s.current == s.fence {
+		return false
+	}
+
+	action(s.current.Node.Item)
+	s.current = s.current.getSuccessor()
+	if s.estimate > 0 {
+		s.estimate--
+	}
+	return true
+}
+
+// ForEachRemaining calls action on every entry left in the range
+func (s *treeMapSpliterator[K, V]) ForEachRemaining(action func(Entry[K, V])) {
+	for s.TryAdvance(action) {
+	}
+}
+
+// TrySplit hands the entries before splitNode over to a new spliterator
+func (s *treeMapSpliterator[K, V]) TrySplit() collections.Spliterator[Entry[K, V]] {
+	split := s.splitNode
+	if split == nil || s.current == nil || s.current == s.fence {
+		return nil
+	}
+	// The traversal may already have moved past the split point
+	if s.tree.compare(s.current.Node.Item.Key(), split.Node.Item.Key()) >= 0 {
+		return nil
+	}
+
+	prefix := &treeMapSpliterator[K, V]{
+		tree:      s.tree,
+		current:   s.current,
+		fence:     split,
+		splitNode: split.left,
+		estimate:  s.estimate / 2,
+	}
+	s.current = split
+	s.splitNode = split.right
+	s.estimate -= prefix.estimate
+	return prefix
+}
+
+// EstimateSize returns an estimate of the number of entries left in the range
+func (s *treeMapSpliterator[K, V]) EstimateSize() int {
+	return s.estimate
+}
@@ -0,0 +1,47 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/jorge-barroso/collections"
+	"github.com/jorge-barroso/collections/collectionstest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTreeMap_Spliterator(t *testing.T) {
+	for _, size := range []int{0, 1, 2, 3, 10, 257} {
+		tm := NewTreeMap[int, int](func(a, b int) bool { return a < b })
+		var want []Entry[int, int]
+		for i := 0; i < size; i++ {
+			// Insert out of order so the tree is not trivially shaped
+			key := (i * 7919) % size
+			tm.Put(key, key*2)
+		}
+		for i := 0; i < size; i++ {
+			want = append(want, Entry[int, int]{key: i, value: i * 2})
+		}
+		collectionstest.TestSpliterator(t, tm.Spliterator, want, true)
+	}
+}
+
+func TestTreeMap_SpliteratorSplitsEvenly(t *testing.T) {
+	tm := NewTreeMap[int, int](func(a, b int) bool { return a < b })
+	for i := 0; i < 1023; i++ {
+		tm.Put(i, i)
+	}
+
+	s := tm.Spliterator()
+	prefix := s.TrySplit()
+	assert.NotNil(t, prefix, "A large tree should split")
+
+	count := func(sp collections.Spliterator[Entry[int, int]]) int {
+		n := 0
+		sp.ForEachRemaining(func(Entry[int, int]) { n++ })
+		return n
+	}
+	left, right := count(prefix), count(s)
+	assert.Equal(t, 1023, left+right, "Splitting should not lose entries")
+	// Red-black trees are only roughly balanced, but the root still leaves a sizeable share on each side
+	assert.Greater(t, left, 1023/5, "The prefix should hold a sizeable share of the entries")
+	assert.Greater(t, right, 1023/5, "The remaining range should hold a sizeable share of the entries")
+}
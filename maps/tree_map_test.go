@@ -1,8 +1,11 @@
 package maps
 
 import (
+	"slices"
 	"testing"
 
+	"github.com/jorge-barroso/collections"
+	"github.com/jorge-barroso/collections/collectionstest"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -117,3 +120,137 @@ func TestTreeMapIterator_Empty(t *testing.T) {
 	assert.Error(t, err, "Expected error when calling Value() on empty iterator")
 	assert.Equal(t, "no more elements", err.Error(), "Unexpected error message for empty map iterator")
 }
+
+func TestTreeMap_All(t *testing.T) {
+	tm := NewTreeMap[int, string](func(a, b int) bool { return a < b })
+	tm.Put(3, "C")
+	tm.Put(1, "A")
+	tm.Put(2, "B")
+
+	var keys []int
+	var values []string
+	for k, v := range tm.All() {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	assert.Equal(t, []int{1, 2, 3}, keys, "Keys should be yielded in ascending order")
+	assert.Equal(t, []string{"A", "B", "C"}, values, "Values should follow the key order")
+
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(tm.Keys()), "Keys() mismatch")
+	assert.Equal(t, []string{"A", "B", "C"}, slices.Collect(tm.Values()), "Values() mismatch")
+}
+
+func TestTreeMap_IteratorConformance(t *testing.T) {
+	tm := NewTreeMap[int, string](func(a, b int) bool { return a < b })
+	collectionstest.TestIterable[Entry[int, string]](t, tm, nil)
+
+	tm.Put(2, "B")
+	tm.Put(3, "C")
+	tm.Put(1, "A")
+	collectionstest.TestIterable[Entry[int, string]](t, tm, []Entry[int, string]{
+		{key: 1, value: "A"},
+		{key: 2, value: "B"},
+		{key: 3, value: "C"},
+	})
+}
+
+func TestTreeMapIterator_FailFast(t *testing.T) {
+	tm := NewTreeMap[int, string](func(a, b int) bool { return a < b })
+	for i := 0; i < 10; i++ {
+		tm.Put(i, "v")
+	}
+	next := 10
+	collectionstest.TestFailFastIterable[Entry[int, string]](t, tm, func() {
+		tm.Put(next, "v")
+		next++
+	})
+
+	// Deleting the successor must not leave the iterator walking stale pointers
+	it := tm.NewIterator()
+	it.Next()
+	assert.NoError(t, tm.Remove(1), "Unexpected error when removing key 1")
+	_, err := it.Value()
+	assert.ErrorIs(t, err, collections.ErrConcurrentModification, "Expected the removal to be detected")
+
+	// Updating an existing key is not a structural modification
+	it = tm.NewIterator()
+	it.Next()
+	tm.Put(0, "updated")
+	entry, err := it.Value()
+	assert.NoError(t, err, "Updating a value should not invalidate the iterator")
+	assert.Equal(t, "updated", entry.Value(), "Value mismatch after updating the current key")
+}
+
+func TestTreeMapIterator_Remove(t *testing.T) {
+	tm := NewTreeMap[int, int](func(a, b int) bool { return a < b })
+	for i := 0; i < 100; i++ {
+		tm.Put(i, i)
+	}
+
+	it := tm.NewMutableIterator()
+	assert.ErrorIs(t, it.Remove(), collections.ErrNoSuchElement, "Remove() before Next() should fail")
+
+	var visited []int
+	for it.Next() {
+		entry, err := it.Value()
+		assert.NoError(t, err, "Unexpected error during iteration")
+		visited = append(visited, entry.Key())
+		if entry.Key()%3 != 0 {
+			assert.NoError(t, it.Remove(), "Unexpected error when removing through the iterator")
+			assert.ErrorIs(t, it.Remove(), collections.ErrNoSuchElement, "Removing twice should fail")
+		}
+	}
+
+	var expected []int
+	for i := 0; i < 100; i++ {
+		if i%3 == 0 {
+			expected = append(expected, i)
+		}
+	}
+	assert.Len(t, visited, 100, "Every entry should be visited exactly once")
+	assert.Equal(t, expected, slices.Collect(tm.Keys()), "Keys mismatch after removing through the iterator")
+	assert.Equal(t, int64(len(expected)), tm.Size(), "Size mismatch after removing through the iterator")
+}
+
+func TestTreeMap_OrderedAndComparatorConstructors(t *testing.T) {
+	ordered := NewOrderedTreeMap[string, int]()
+	for i, key := range []string{"pear", "apple", "fig"} {
+		ordered.Put(key, i)
+	}
+	assert.Equal(t, []string{"apple", "fig", "pear"}, slices.Collect(ordered.Keys()), "NewOrderedTreeMap should sort keys naturally")
+
+	type version struct{ major, minor int }
+	newest := NewTreeMapWithComparator[version, string](
+		collections.Comparing(func(v version) int { return v.major }).
+			ThenComparing(collections.Comparing(func(v version) int { return v.minor })).
+			Reversed())
+	newest.Put(version{1, 2}, "b")
+	newest.Put(version{2, 0}, "c")
+	newest.Put(version{1, 10}, "a")
+	assert.Equal(t, []version{{2, 0}, {1, 10}, {1, 2}}, slices.Collect(newest.Keys()), "Keys should follow the comparator")
+
+	floor, ok := newest.FloorEntry(version{1, 5})
+	assert.True(t, ok, "Expected a floor entry in comparator order")
+	assert.Equal(t, version{1, 10}, floor.Key(), "The floor should be the last key not after the argument in comparator order")
+}
+
+func TestTreeMap_OneComparisonPerNode(t *testing.T) {
+	comparisons := 0
+	tm := NewTreeMapWithComparator[int, int](func(a, b int) int {
+		comparisons++
+		return a - b
+	})
+	for i := range 1000 {
+		tm.Put(i, i)
+	}
+
+	depth := 0
+	for node := tm.findNode(999); node != nil; node = node.parent {
+		depth++
+	}
+
+	comparisons = 0
+	_, err := tm.Get(999)
+	assert.NoError(t, err, "Unexpected error when getting key 999")
+	assert.Equal(t, depth, comparisons, "A lookup should compare the key once per node on its path")
+}
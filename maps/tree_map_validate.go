@@ -0,0 +1,158 @@
+package maps
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+)
+
+// Validate checks the invariants of the red-black tree backing the map: keys in strictly ascending order,
+// a black root, no red node with a red child, the same number of black nodes on every path from the root,
+// parent pointers matching the children, and subtree sizes and the size of the map matching the nodes.
+// It returns an error wrapping errCorruptTree describing the first violation found, in O(n).
+func (t *TreeMap[K, V]) Validate() error {
+	if t.root == nil {
+		if t.size != 0 {
+			return fmt.Errorf("%w: empty tree with size %d", errCorruptTree, t.size)
+		}
+		return nil
+	}
+	if t.root.parent != nil {
+		return fmt.Errorf("%w: root %v has a parent", errCorruptTree, t.root.Node.Item.Key())
+	}
+	if t.root.isRed() {
+		return fmt.Errorf("%w: root %v is red", errCorruptTree, t.root.Node.Item.Key())
+	}
+
+	if _, err := t.validateNode(t.root, nil, nil); err != nil {
+		return err
+	}
+	if t.root.count != t.size {
+		return fmt.Errorf("%w: tree holds %d nodes but size is %d", errCorruptTree, t.root.count, t.size)
+	}
+	return nil
+}
+
+// validateNode checks the subtree rooted at node, whose keys must fall strictly between the keys of
+// low and high when they are not nil, and returns its black height
+func (t *TreeMap[K, V]) validateNode(node, low, high *rbNode[K, V]) (int, error) {
+	if node == nil {
+		return 1, nil
+	}
+
+	key := node.Node.Item.Key()
+	if low != nil && t.compare(key, low.Node.Item.Key()) <= 0 {
+		return 0, fmt.Errorf("%w: key %v is not after %v", errCorruptTree, key, low.Node.Item.Key())
+	}
+	if high != nil && t.compare(key, high.Node.Item.Key()) >= 0 {
+		return 0, fmt.Errorf("%w: key %v is not before %v", errCorruptTree, key, high.Node.Item.Key())
+	}
+
+	for _, child := range node.children() {
+		if child.parent != node {
+			return 0, fmt.Errorf("%w: parent of %v is not %v", errCorruptTree, child.Node.Item.Key(), key)
+		}
+		if node.isRed() && child.isRed() {
+			return 0, fmt.Errorf("%w: red node %v has red child %v", errCorruptTree, key, child.Node.Item.Key())
+		}
+	}
+
+	leftHeight, err := t.validateNode(node.left, low, node)
+	if err != nil {
+		return 0, err
+	}
+	rightHeight, err := t.validateNode(node.right, node, high)
+	if err != nil {
+		return 0, err
+	}
+	if leftHeight != rightHeight {
+		return 0, fmt.Errorf("%w: subtrees of %v have black heights %d and %d", errCorruptTree, key, leftHeight, rightHeight)
+	}
+	if count := node.left.subtreeSize() + node.right.subtreeSize() + 1; node.count != count {
+		return 0, fmt.Errorf("%w: subtree of %v holds %d nodes but records %d", errCorruptTree, key, count, node.count)
+	}
+
+	if node.isBlack() {
+		leftHeight++
+	}
+	return leftHeight, nil
+}
+
+// Height returns the number of nodes on the longest path from the root to a leaf, 0 if the map is empty
+func (t *TreeMap[K, V]) Height() int {
+	return t.root.height()
+}
+
+// height returns the number of nodes on the longest path from the node down to a leaf
+func (n *rbNode[K, V]) height() int {
+	if n == nil {
+		return 0
+	}
+	return max(n.left.height(), n.right.height()) + 1
+}
+
+// WriteDOT writes the tree to w as a Graphviz DOT digraph, one node per entry labelled with its key
+// and filled with its colour, and one edge per child labelled L or R
+func (t *TreeMap[K, V]) WriteDOT(w io.Writer) error {
+	out := bufio.NewWriter(w)
+	fmt.Fprintln(out, "digraph TreeMap {")
+	fmt.Fprintln(out, "\tnode [style=filled, fontcolor=white];")
+
+	nodes := 0
+	var visit func(node *rbNode[K, V]) int
+	visit = func(node *rbNode[K, V]) int {
+		id := nodes
+		nodes++
+		fmt.Fprintf(out, "\tn%d [label=%q, fillcolor=%s];\n", id, fmt.Sprint(node.Node.Item.Key()), node.colorName())
+		for side, child := range node.children() {
+			fmt.Fprintf(out, "\tn%d -> n%d [label=%q];\n", id, visit(child), side)
+		}
+		return id
+	}
+	if t.root != nil {
+		visit(t.root)
+	}
+
+	fmt.Fprintln(out, "}")
+	// bufio.Writer keeps the first error, Flush reports it
+	return out.Flush()
+}
+
+// WriteText writes the tree to w as indented text, one line per entry with its key and colour,
+// children indented below their parent and prefixed with L or R
+func (t *TreeMap[K, V]) WriteText(w io.Writer) error {
+	out := bufio.NewWriter(w)
+	var visit func(node *rbNode[K, V], prefix string, depth int)
+	visit = func(node *rbNode[K, V], prefix string, depth int) {
+		fmt.Fprintf(out, "%s%s%v (%s)\n", strings.Repeat("  ", depth), prefix, node.Node.Item.Key(), node.colorName())
+		for side, child := range node.children() {
+			visit(child, side+": ", depth+1)
+		}
+	}
+	if t.root != nil {
+		visit(t.root, "", 0)
+	}
+	return out.Flush()
+}
+
+// colorName returns the colour of the node as used in the dumps
+func (n *rbNode[K, V]) colorName() string {
+	if n.isRed() {
+		return "red"
+	}
+	return "black"
+}
+
+// children returns an iter.Seq2 over the children the node has, left first, each with L or R for its side
+func (n *rbNode[K, V]) children() iter.Seq2[string, *rbNode[K, V]] {
+	return func(yield func(string, *rbNode[K, V]) bool) {
+		if n.left != nil && !yield("L", n.left) {
+			return
+		}
+		if n.right != nil {
+			yield("R", n.right)
+		}
+	}
+}
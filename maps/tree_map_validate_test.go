@@ -0,0 +1,152 @@
+package maps
+
+import (
+	"iter"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTreeMap_ValidateAfterRandomPutsAndRemoves(t *testing.T) {
+	// Each operation puts its key when positive and removes it otherwise, keys are kept small to force collisions
+	property := func(operations []int16) bool {
+		tm := newIntTreeMap()
+		reference := make(map[int]bool)
+		for _, operation := range operations {
+			key := int(operation) % 64
+			if operation > 0 {
+				tm.Put(key, key)
+				reference[key] = true
+			} else {
+				_ = tm.Remove(-key)
+				delete(reference, -key)
+			}
+
+			if err := tm.Validate(); err != nil {
+				t.Log(err)
+				return false
+			}
+		}
+		return tm.Size() == int64(len(reference))
+	}
+
+	config := &quick.Config{MaxCount: 300, Rand: rand.New(rand.NewSource(25))}
+	assert.NoError(t, quick.Check(property, config), "The tree should stay valid after every put and remove")
+}
+
+func TestTreeMap_ValidateAfterEveryKindOfRemoval(t *testing.T) {
+	random := rand.New(rand.NewSource(25))
+	tm := newIntTreeMap()
+
+	for range 500 {
+		switch random.Intn(8) {
+		case 0:
+			_, _ = tm.PollFirst()
+		case 1:
+			_, _ = tm.PollLast()
+		case 2:
+			if tm.Size() > 0 {
+				_, _ = tm.RemoveAt(random.Int63n(tm.Size()))
+			}
+		case 3:
+			from := random.Intn(1000)
+			view, _ := tm.SubMap(from, from+20)
+			view.Clear()
+		case 4:
+			it := tm.NewDescendingIterator()
+			for it.Next() {
+				if random.Intn(4) == 0 {
+					_ = it.Remove()
+				}
+			}
+		case 5:
+			tm.ComputeIfPresent(random.Intn(1000), func(int, int) (int, bool) { return 0, false })
+		default:
+			for range 10 {
+				key := random.Intn(1000)
+				tm.Put(key, key)
+			}
+		}
+		assert.NoError(t, tm.Validate(), "The tree should stay valid after every change")
+	}
+}
+
+func TestTreeMap_ValidateDetectsCorruption(t *testing.T) {
+	corruptions := map[string]func(tm *TreeMap[int, int]){
+		"red root":          func(tm *TreeMap[int, int]) { tm.root.color = red },
+		"red red":           func(tm *TreeMap[int, int]) { tm.root.left.color = red; tm.root.left.left.color = red },
+		"black height":      func(tm *TreeMap[int, int]) { tm.root.left.left.color = red },
+		"order":             func(tm *TreeMap[int, int]) { tm.root.left.right.Node.Item.key = 100 },
+		"parent":            func(tm *TreeMap[int, int]) { tm.root.right.left.parent = tm.root },
+		"subtree size":      func(tm *TreeMap[int, int]) { tm.root.right.count++ },
+		"size":              func(tm *TreeMap[int, int]) { tm.size++ },
+		"root with parent":  func(tm *TreeMap[int, int]) { tm.root.parent = tm.root.left },
+		"empty with a size": func(tm *TreeMap[int, int]) { tm.root = nil },
+	}
+
+	for name, corrupt := range corruptions {
+		t.Run(name, func(t *testing.T) {
+			// A perfect tree of black nodes, 4 at the root with 2 and 6 below it
+			tm := newIntTreeMap(4, 2, 6, 1, 3, 5, 7)
+			for node := range tm.nodes() {
+				node.color = black
+			}
+			assert.NoError(t, tm.Validate(), "The tree should be valid before being corrupted")
+
+			corrupt(tm)
+			assert.ErrorIs(t, tm.Validate(), errCorruptTree, "Validate should detect the corruption")
+		})
+	}
+}
+
+// nodes returns an iter.Seq over the nodes of the tree in ascending key order
+func (t *TreeMap[K, V]) nodes() iter.Seq[*rbNode[K, V]] {
+	return func(yield func(*rbNode[K, V]) bool) {
+		for node := t.firstNode(); node != nil; node = node.getSuccessor() {
+			if !yield(node) {
+				return
+			}
+		}
+	}
+}
+
+func TestTreeMap_Height(t *testing.T) {
+	assert.Equal(t, 0, newIntTreeMap().Height(), "An empty map should have height 0")
+	assert.Equal(t, 1, newIntTreeMap(1).Height(), "A single entry should have height 1")
+	assert.Equal(t, 2, newIntTreeMap(2, 1, 3).Height(), "Expected a balanced tree of three entries")
+
+	tm := newIntTreeMap()
+	for i := range 1000 {
+		tm.Put(i, i)
+	}
+	limit := 2 * math.Log2(float64(tm.Size()+1))
+	assert.LessOrEqual(t, float64(tm.Height()), limit, "A red-black tree should be at most 2·log2(n+1) high")
+}
+
+func TestTreeMap_WriteText(t *testing.T) {
+	var out strings.Builder
+	assert.NoError(t, newIntTreeMap(2, 1, 3, 4).WriteText(&out), "Unexpected error when writing the tree")
+	assert.Equal(t, "2 (black)\n  L: 1 (black)\n  R: 3 (black)\n    R: 4 (red)\n", out.String(), "Unexpected text dump")
+
+	out.Reset()
+	assert.NoError(t, newIntTreeMap().WriteText(&out), "Unexpected error when writing an empty tree")
+	assert.Empty(t, out.String(), "An empty tree should write nothing")
+}
+
+func TestTreeMap_WriteDOT(t *testing.T) {
+	var out strings.Builder
+	assert.NoError(t, newIntTreeMap(2, 1, 3).WriteDOT(&out), "Unexpected error when writing the tree")
+	assert.Equal(t, `digraph TreeMap {
+	node [style=filled, fontcolor=white];
+	n0 [label="2", fillcolor=black];
+	n1 [label="1", fillcolor=red];
+	n0 -> n1 [label="L"];
+	n2 [label="3", fillcolor=red];
+	n0 -> n2 [label="R"];
+}
+`, out.String(), "Unexpected DOT dump")
+}
@@ -0,0 +1,279 @@
+package maps
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+
+	"github.com/jorge-barroso/collections"
+)
+
+// TreeSubMap is a live view of the entries of a TreeMap whose keys fall within a range.
+// Changes to the TreeMap show through the view and changes made through the view go to the TreeMap.
+// Keys outside the range cannot be put through the view: TryPut and TryPutAll reject them with an error.
+// Put and PutAll have no error to return and leave the map unchanged for them, while the compute family
+// reports them as absent and not stored.
+type TreeSubMap[K comparable, V any] struct {
+	tree   *TreeMap[K, V]
+	bounds keyRange[K]
+}
+
+// Ensure TreeSubMap implements both Map and Iterable interfaces
+var _ Map[string, int] = (*TreeSubMap[string, int])(nil)
+var _ collections.Iterable[Entry[string, int]] = (*TreeSubMap[string, int])(nil)
+var _ computeOps[string, int] = (*TreeSubMap[string, int])(nil)
+
+// SubMap returns a view of the entries with keys from from, inclusive, to to, exclusive, failing if to is before from
+func (t *TreeMap[K, V]) SubMap(from, to K) (*TreeSubMap[K, V], error) {
+	return t.view(keyRange[K]{}).SubMap(from, to)
+}
+
+// HeadMap returns a view of the entries with keys strictly less than to
+func (t *TreeMap[K, V]) HeadMap(to K) *TreeSubMap[K, V] {
+	return t.view(keyRange[K]{to: to, hasTo: true})
+}
+
+// TailMap returns a view of the entries with keys greater than or equal to from
+func (t *TreeMap[K, V]) TailMap(from K) *TreeSubMap[K, V] {
+	return t.view(keyRange[K]{from: from, hasFrom: true, fromInclusive: true})
+}
+
+// view returns a view of the entries with keys within bounds
+func (t *TreeMap[K, V]) view(bounds keyRange[K]) *TreeSubMap[K, V] {
+	return &TreeSubMap[K, V]{tree: t, bounds: bounds}
+}
+
+// SubMap returns a view of the entries of this view with keys from from, inclusive, to to, exclusive.
+// The new range must lie within the range of this view.
+func (s *TreeSubMap[K, V]) SubMap(from, to K) (*TreeSubMap[K, V], error) {
+	if s.tree.compare(to, from) < 0 {
+		return nil, errInvalidRange
+	}
+	head, err := s.HeadMap(to)
+	if err != nil {
+		return nil, err
+	}
+	return head.TailMap(from)
+}
+
+// HeadMap returns a view of the entries of this view with keys strictly less than to,
+// which must lie within the range of this view or be its upper end
+func (s *TreeSubMap[K, V]) HeadMap(to K) (*TreeSubMap[K, V], error) {
+	if !s.canBound(to) {
+		return nil, errInvalidRange
+	}
+	bounds := s.bounds
+	bounds.to, bounds.hasTo, bounds.toInclusive = to, true, false
+	return s.tree.view(bounds), nil
+}
+
+// TailMap returns a view of the entries of this view with keys greater than or equal to from,
+// which must lie within the range of this view or be its upper end
+func (s *TreeSubMap[K, V]) TailMap(from K) (*TreeSubMap[K, V], error) {
+	if !s.canBound(from) {
+		return nil, errInvalidRange
+	}
+	bounds := s.bounds
+	bounds.from, bounds.hasFrom, bounds.fromInclusive = from, true, true
+	return s.tree.view(bounds), nil
+}
+
+// canBound reports whether key can be an end of a range within the view,
+// that is whether it falls within the view or is its exclusive upper end
+func (s *TreeSubMap[K, V]) canBound(key K) bool {
+	if s.inRange(key) {
+		return true
+	}
+	isUpperEnd := s.bounds.hasTo && s.tree.compare(key, s.bounds.to) == 0
+	return isUpperEnd && !s.bounds.tooLow(key, s.tree.compare)
+}
+
+// inRange reports whether key falls within the range of the view
+func (s *TreeSubMap[K, V]) inRange(key K) bool {
+	return s.bounds.contains(key, s.tree.compare)
+}
+
+// TryPut inserts or updates a key-value pair, rejecting keys outside the range of the view with errKeyOutOfRange
+func (s *TreeSubMap[K, V]) TryPut(key K, value V) error {
+	if !s.inRange(key) {
+		return fmt.Errorf("%w: %v", errKeyOutOfRange, key)
+	}
+	s.tree.Put(key, value)
+	return nil
+}
+
+// TryPutAll inserts or updates every key-value pair of other, unless any key is outside the range of the view,
+// in which case nothing is put and the first such key is rejected with errKeyOutOfRange
+func (s *TreeSubMap[K, V]) TryPutAll(other Map[K, V]) error {
+	for key := range other.Keys() {
+		if !s.inRange(key) {
+			return fmt.Errorf("%w: %v", errKeyOutOfRange, key)
+		}
+	}
+	for key, value := range other.All() {
+		s.tree.Put(key, value)
+	}
+	return nil
+}
+
+// Put inserts or updates a key-value pair. Keys outside the range of the view leave the map unchanged,
+// use TryPut to have them rejected with an error
+func (s *TreeSubMap[K, V]) Put(key K, value V) {
+	_ = s.TryPut(key, value)
+}
+
+// PutAll inserts or updates every key-value pair of other whose key is within the range of the view,
+// skipping the others. Use TryPutAll to have them rejected with an error
+func (s *TreeSubMap[K, V]) PutAll(other Map[K, V]) {
+	for key, value := range other.All() {
+		s.Put(key, value)
+	}
+}
+
+// Get retrieves the value associated with a key within the range of the view
+func (s *TreeSubMap[K, V]) Get(key K) (V, error) {
+	if value, ok := s.lookup(key); ok {
+		return value, nil
+	}
+	var zero V
+	return zero, errors.New("key not found")
+}
+
+// GetOrDefault retrieves the value associated with a key, or defaultValue if the key is not in the view
+func (s *TreeSubMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	if value, ok := s.lookup(key); ok {
+		return value
+	}
+	return defaultValue
+}
+
+// Remove removes a key-value pair within the range of the view
+func (s *TreeSubMap[K, V]) Remove(key K) error {
+	if !s.inRange(key) {
+		return errors.New("key not found")
+	}
+	return s.tree.Remove(key)
+}
+
+// ContainsKey reports whether the key is in the view
+func (s *TreeSubMap[K, V]) ContainsKey(key K) bool {
+	return s.inRange(key) && s.tree.ContainsKey(key)
+}
+
+// ContainsValue reports whether any key of the view is associated with a value equal to value according to eq
+func (s *TreeSubMap[K, V]) ContainsValue(value V, eq func(a, b V) bool) bool {
+	return containsValue(s.Values(), value, eq)
+}
+
+// Size counts the entries within the range of the view in O(log n), it is not cached so it always reflects the TreeMap
+func (s *TreeSubMap[K, V]) Size() int64 {
+	return s.tree.countWithin(s.bounds)
+}
+
+// IsEmpty reports whether the view has no key-value pairs
+func (s *TreeSubMap[K, V]) IsEmpty() bool {
+	return s.tree.lowestNode(s.bounds) == nil
+}
+
+// Clear removes every key-value pair of the view from the TreeMap
+func (s *TreeSubMap[K, V]) Clear() {
+	it := s.NewMutableIterator()
+	for it.Next() {
+		_ = it.Remove()
+	}
+}
+
+// NewIterator returns a new iterator over the view in ascending key order
+func (s *TreeSubMap[K, V]) NewIterator() collections.Iterator[Entry[K, V]] {
+	return s.NewMutableIterator()
+}
+
+// NewMutableIterator returns a new iterator over the view in ascending key order that can also remove entries
+func (s *TreeSubMap[K, V]) NewMutableIterator() collections.MutableIterator[Entry[K, V]] {
+	return newTreeMapIterator(s.tree, s.tree.lowestNode(s.bounds), s.bounds, false)
+}
+
+// All returns an iter.Seq2 over the key-value pairs of the view in ascending key order
+func (s *TreeSubMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for node := s.tree.lowestNode(s.bounds); node != nil; node = node.getSuccessor() {
+			if s.bounds.tooHigh(node.Node.Item.Key(), s.tree.compare) || !yield(node.Node.Item.Key(), node.Node.Item.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iter.Seq over the keys of the view in ascending order
+func (s *TreeSubMap[K, V]) Keys() iter.Seq[K] {
+	return keysOf(s.All())
+}
+
+// Values returns an iter.Seq over the values of the view in ascending key order
+func (s *TreeSubMap[K, V]) Values() iter.Seq[V] {
+	return valuesOf(s.All())
+}
+
+// Entries returns an iter.Seq over the key-value pairs of the view in ascending key order
+func (s *TreeSubMap[K, V]) Entries() iter.Seq[Entry[K, V]] {
+	return entriesOf(s.All())
+}
+
+// PutIfAbsent associates value with key unless the key is already present, returning the value
+// associated with key afterwards and whether it was already present
+func (s *TreeSubMap[K, V]) PutIfAbsent(key K, value V) (V, bool) {
+	return putIfAbsent[K, V](s, key, value)
+}
+
+// ComputeIfAbsent associates the result of mapping with key unless the key is already present,
+// returning the value associated with key afterwards. mapping must not modify the map
+func (s *TreeSubMap[K, V]) ComputeIfAbsent(key K, mapping func(key K) V) V {
+	return computeIfAbsent[K, V](s, key, mapping)
+}
+
+// ComputeIfPresent replaces the value associated with key, if any, with the result of remapping,
+// removing the key when remapping returns false. remapping must not modify the map
+func (s *TreeSubMap[K, V]) ComputeIfPresent(key K, remapping func(key K, value V) (V, bool)) (V, bool) {
+	return computeIfPresent[K, V](s, key, remapping)
+}
+
+// Compute replaces the value associated with key with the result of remapping, which also learns whether
+// the key was present, removing the key when remapping returns false. remapping must not modify the map
+func (s *TreeSubMap[K, V]) Compute(key K, remapping func(key K, value V, present bool) (V, bool)) (V, bool) {
+	return compute[K, V](s, key, remapping)
+}
+
+// Merge associates value with key if the key is absent, otherwise combines both values with merging,
+// removing the key when merging returns false. merging must not modify the map
+func (s *TreeSubMap[K, V]) Merge(key K, value V, merging func(old, value V) (V, bool)) (V, bool) {
+	return merge[K, V](s, key, value, merging)
+}
+
+// Replace associates newValue with key only if it is currently associated with a value equal to oldValue according to eq
+func (s *TreeSubMap[K, V]) Replace(key K, oldValue, newValue V, eq func(a, b V) bool) bool {
+	return replace[K, V](s, key, oldValue, newValue, eq)
+}
+
+// RemoveIf removes key only if it is currently associated with a value equal to expected according to eq
+func (s *TreeSubMap[K, V]) RemoveIf(key K, expected V, eq func(a, b V) bool) bool {
+	return removeIfEqual[K, V](s, key, expected, eq)
+}
+
+// lookup returns the value associated with key and whether the key is within the view
+func (s *TreeSubMap[K, V]) lookup(key K) (V, bool) {
+	if !s.inRange(key) {
+		var zero V
+		return zero, false
+	}
+	return s.tree.lookup(key)
+}
+
+// store inserts or updates a key-value pair, reporting false for keys outside the range of the view
+func (s *TreeSubMap[K, V]) store(key K, value V) bool {
+	return s.TryPut(key, value) == nil
+}
+
+// remove removes key, which must be present
+func (s *TreeSubMap[K, V]) remove(key K) {
+	s.tree.remove(key)
+}
@@ -0,0 +1,156 @@
+package maps
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jorge-barroso/collections"
+	"github.com/jorge-barroso/collections/collectionstest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTreeSubMap_Bounds(t *testing.T) {
+	tm := newIntTreeMap(10, 20, 30, 40, 50)
+
+	sub, err := tm.SubMap(20, 40)
+	assert.NoError(t, err, "Unexpected error when creating a sub map")
+	assert.Equal(t, []int{20, 30}, slices.Collect(sub.Keys()), "SubMap should include from and exclude to")
+	assert.Equal(t, []int{10, 20}, slices.Collect(tm.HeadMap(30).Keys()), "HeadMap should exclude to")
+	assert.Equal(t, []int{30, 40, 50}, slices.Collect(tm.TailMap(30).Keys()), "TailMap should include from")
+
+	empty, err := tm.SubMap(30, 30)
+	assert.NoError(t, err, "A sub map with equal ends should be allowed")
+	assert.True(t, empty.IsEmpty(), "A sub map with equal ends should be empty")
+	_, err = tm.SubMap(40, 20)
+	assert.Error(t, err, "Reversed ends should be rejected")
+}
+
+func TestTreeSubMap_NestedViews(t *testing.T) {
+	tm := newIntTreeMap(10, 20, 30, 40, 50)
+	sub, _ := tm.SubMap(20, 50)
+
+	nested, err := sub.SubMap(30, 50)
+	assert.NoError(t, err, "A range ending at the end of the view should be allowed")
+	assert.Equal(t, []int{30, 40}, slices.Collect(nested.Keys()), "Unexpected keys in the nested view")
+
+	head, err := sub.HeadMap(40)
+	assert.NoError(t, err, "Unexpected error when creating a nested head map")
+	assert.Equal(t, []int{20, 30}, slices.Collect(head.Keys()), "Unexpected keys in the nested head map")
+
+	tail, err := sub.TailMap(25)
+	assert.NoError(t, err, "Unexpected error when creating a nested tail map")
+	assert.Equal(t, []int{30, 40}, slices.Collect(tail.Keys()), "Unexpected keys in the nested tail map")
+
+	_, err = sub.SubMap(10, 30)
+	assert.Error(t, err, "A range starting before the view should be rejected")
+	_, err = sub.HeadMap(60)
+	assert.Error(t, err, "A range ending past the view should be rejected")
+	_, err = sub.HeadMap(10)
+	assert.Error(t, err, "A range ending before the view should be rejected")
+	_, err = sub.TailMap(60)
+	assert.Error(t, err, "A range starting past the view should be rejected")
+}
+
+func TestTreeSubMap_ReflectsParent(t *testing.T) {
+	tm := newIntTreeMap(10, 20, 30)
+	view := tm.TailMap(15)
+	assert.Equal(t, int64(2), view.Size(), "Unexpected size of the view")
+
+	tm.Put(25, 25)
+	tm.Put(5, 5)
+	assert.NoError(t, tm.Remove(30), "Unexpected error when removing key 30")
+	assert.Equal(t, []int{20, 25}, slices.Collect(view.Keys()), "The view should reflect changes to the parent")
+	assert.Equal(t, int64(2), view.Size(), "The size of the view should follow the parent")
+	assert.True(t, view.ContainsKey(25), "Expected key 25 to be in the view")
+	assert.False(t, view.ContainsKey(5), "Expected key 5 not to be in the view")
+
+	view.Put(40, 40)
+	assert.NoError(t, view.Remove(20), "Unexpected error when removing through the view")
+	assert.Error(t, view.Remove(5), "Keys outside the view should not be removable through it")
+	assert.Equal(t, []int{5, 10, 25, 40}, slices.Collect(tm.Keys()), "Changes through the view should reach the parent")
+}
+
+func TestTreeSubMap_RejectsOutOfRangeKeys(t *testing.T) {
+	tm := newIntTreeMap()
+	view, _ := tm.SubMap(10, 20)
+
+	assert.NoError(t, view.TryPut(10, 1), "Keys within the range should be accepted")
+	assert.ErrorIs(t, view.TryPut(20, 1), errKeyOutOfRange, "The exclusive end should be rejected")
+	assert.ErrorIs(t, view.TryPut(5, 1), errKeyOutOfRange, "Keys below the range should be rejected")
+	assert.NotPanics(t, func() { view.Put(25, 1) }, "Put should ignore keys outside the range")
+	assert.ErrorIs(t, view.TryPutAll(newIntTreeMap(12, 25)), errKeyOutOfRange, "TryPutAll should reject keys outside the range")
+	assert.Equal(t, []int{10}, slices.Collect(tm.Keys()), "Rejected keys should not reach the parent")
+	assert.NoError(t, view.TryPutAll(newIntTreeMap(12, 15)), "TryPutAll should accept keys within the range")
+	assert.Equal(t, []int{10, 12, 15}, slices.Collect(tm.Keys()), "Accepted keys should reach the parent")
+
+	_, err := view.Get(5)
+	assert.Error(t, err, "Keys outside the view should not be found")
+	assert.Equal(t, 0, view.GetOrDefault(5, 0), "Keys outside the view should get the default")
+}
+
+func TestTreeSubMap_OutOfRangePutThroughMap(t *testing.T) {
+	tm := newIntTreeMap(1, 15, 30)
+	view, _ := tm.SubMap(10, 20)
+	var m Map[int, int] = view
+
+	assert.NotPanics(t, func() {
+		m.Put(25, 25)
+		m.PutAll(newIntTreeMap(5, 12, 40))
+		m.PutIfAbsent(0, 0)
+		m.Compute(50, func(key, _ int, _ bool) (int, bool) { return key, true })
+	}, "Out-of-range keys put through a Map should not panic")
+	assert.Equal(t, []int{1, 12, 15, 30}, slices.Collect(tm.Keys()), "Only keys within the range should reach the parent")
+	assert.Equal(t, int64(2), m.Size(), "The view should only hold keys within its range")
+}
+
+func TestTreeSubMap_ComputeReportsOutOfRangeKeysAsNotStored(t *testing.T) {
+	tm := newIntTreeMap(15)
+	view, _ := tm.SubMap(10, 20)
+	keep := func(key, _ int, _ bool) (int, bool) { return key, true }
+
+	value, present := view.PutIfAbsent(25, 1)
+	assert.False(t, present, "PutIfAbsent should report an out-of-range key as absent")
+	assert.Equal(t, 0, value, "PutIfAbsent should not return a value for an out-of-range key")
+	assert.Equal(t, 0, view.ComputeIfAbsent(25, func(int) int { return 1 }), "ComputeIfAbsent should not report an out-of-range key as stored")
+	value, stored := view.Compute(25, keep)
+	assert.False(t, stored, "Compute should not report an out-of-range key as stored")
+	assert.Equal(t, 0, value, "Compute should not return a value for an out-of-range key")
+	value, stored = view.Merge(5, 1, func(old, value int) (int, bool) { return old + value, true })
+	assert.False(t, stored, "Merge should not report an out-of-range key as stored")
+	assert.Equal(t, 0, value, "Merge should not return a value for an out-of-range key")
+	assert.Equal(t, []int{15}, slices.Collect(tm.Keys()), "Out-of-range keys should not reach the parent")
+
+	value, stored = view.Compute(15, keep)
+	assert.True(t, stored, "Compute should report keys within the range as stored")
+	assert.Equal(t, 15, value, "Unexpected value computed for key 15")
+}
+
+func TestTreeSubMap_Clear(t *testing.T) {
+	tm := newIntTreeMap(1, 2, 3, 4, 5, 6)
+	view, _ := tm.SubMap(2, 5)
+	view.Clear()
+	assert.True(t, view.IsEmpty(), "The view should be empty after Clear()")
+	assert.Equal(t, []int{1, 5, 6}, slices.Collect(tm.Keys()), "Clear should only remove the keys of the view")
+}
+
+func TestTreeSubMap_IteratorConformance(t *testing.T) {
+	tm := newIntTreeMap(1, 2, 3, 4, 5)
+	view, _ := tm.SubMap(2, 5)
+	collectionstest.TestIterable[Entry[int, int]](t, view, []Entry[int, int]{
+		{key: 2, value: 2},
+		{key: 3, value: 3},
+		{key: 4, value: 4},
+	})
+
+	next := 10
+	collectionstest.TestFailFastIterable[Entry[int, int]](t, view, func() {
+		tm.Put(next, next)
+		next++
+	})
+
+	it := view.NewIterator()
+	it.Next()
+	assert.NoError(t, tm.Remove(1), "Unexpected error when removing key 1 from the parent")
+	_, err := it.Value()
+	assert.ErrorIs(t, err, collections.ErrConcurrentModification, "Changes to the parent should be detected")
+}
@@ -4,3 +4,10 @@ type Node[T any] struct {
 	Item T
 	Next *Node[T]
 }
+
+// DoublyNode is a node that links to both its successor and its predecessor
+type DoublyNode[T any] struct {
+	Item T
+	Prev *DoublyNode[T]
+	Next *DoublyNode[T]
+}
@@ -0,0 +1,16 @@
+package queues
+
+// Deque represents a generic double-ended queue, supporting insertion, removal and inspection at both ends.
+// AddFirst and AddLast insert an item at the head or the tail of the deque.
+// RemoveFirst and RemoveLast retrieve and remove the head or the tail, returning an error if the deque is empty.
+// PeekFirst and PeekLast retrieve but do not remove the head or the tail, returning an error if the deque is empty.
+// Embeds Queue, whose operations insert at the tail and retrieve from the head.
+type Deque[T any] interface {
+	AddFirst(item T)
+	AddLast(item T)
+	RemoveFirst() (T, error)
+	RemoveLast() (T, error)
+	PeekFirst() (T, error)
+	PeekLast() (T, error)
+	Queue[T] // Embedding the Queue interface
+}
@@ -0,0 +1,83 @@
+package collections
+
+import "iter"
+
+// ToSeq adapts any Iterable into an iter.Seq so it can be used with range-over-func
+// and the standard slices and maps helpers
+func ToSeq[T any](iterable Iterable[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		it := iterable.NewIterator()
+		for it.Next() {
+			value, err := it.Value()
+			if err != nil {
+				return
+			}
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq adapts an iter.Seq into an Iterable, every iterator created from it
+// pulls values lazily from a fresh run of the sequence
+func FromSeq[T any](seq iter.Seq[T]) Iterable[T] {
+	return &seqIterable[T]{seq: seq}
+}
+
+// seqIterable wraps an iter.Seq so it satisfies Iterable
+type seqIterable[T any] struct {
+	seq iter.Seq[T]
+}
+
+// NewIterator returns a new pull-based iterator over the wrapped sequence
+func (s *seqIterable[T]) NewIterator() Iterator[T] {
+	next, stop := iter.Pull(s.seq)
+	return &SeqIterator[T]{
+		next: next,
+		stop: stop,
+	}
+}
+
+// SeqIterator pulls values out of an iter.Seq one at a time.
+// The underlying sequence is released once it is exhausted, callers abandoning
+// the iteration early should call Stop to release it themselves.
+type SeqIterator[T any] struct {
+	next    func() (T, bool)
+	stop    func()
+	current T
+	started bool
+	done    bool
+}
+
+// Next advances the iterator to the next value of the sequence
+func (s *SeqIterator[T]) Next() bool {
+	if s.done {
+		return false
+	}
+
+	value, ok := s.next()
+	if !ok {
+		s.Stop()
+		return false
+	}
+
+	s.current = value
+	s.started = true
+	return true
+}
+
+// Value returns the value the iterator is currently positioned on
+func (s *SeqIterator[T]) Value() (T, error) {
+	if !s.started {
+		var zero T
+		return zero, ErrNoSuchElement
+	}
+	return s.current, nil
+}
+
+// Stop releases the underlying sequence, after which Next always returns false
+func (s *SeqIterator[T]) Stop() {
+	s.done = true
+	s.stop()
+}
@@ -0,0 +1,48 @@
+package collections
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromSeq_Iterator(t *testing.T) {
+	iterable := FromSeq(slices.Values([]int{1, 2, 3}))
+
+	it := iterable.NewIterator()
+	_, err := it.Value()
+	assert.Error(t, err, "Expected error when calling Value() before Next()")
+
+	var values []int
+	for it.Next() {
+		value, err := it.Value()
+		assert.NoError(t, err, "Unexpected error during iteration")
+		values = append(values, value)
+	}
+	assert.Equal(t, []int{1, 2, 3}, values, "Values mismatch when iterating over a sequence")
+	assert.False(t, it.Next(), "Next() should keep returning false once the sequence is exhausted")
+}
+
+func TestFromSeq_Stop(t *testing.T) {
+	it := FromSeq(slices.Values([]int{1, 2, 3})).NewIterator().(*SeqIterator[int])
+
+	assert.True(t, it.Next(), "Next() should return true for the first element")
+	it.Stop()
+	assert.False(t, it.Next(), "Next() should return false after Stop()")
+}
+
+func TestToSeq_RoundTrip(t *testing.T) {
+	values := []string{"a", "b", "c"}
+	iterable := FromSeq(slices.Values(values))
+
+	assert.Equal(t, values, slices.Collect(ToSeq(iterable)), "Values mismatch after a round trip through Iterable")
+
+	// Breaking out early must stop the iteration
+	var first []string
+	for value := range ToSeq(iterable) {
+		first = append(first, value)
+		break
+	}
+	assert.Equal(t, []string{"a"}, first, "Expected the loop to stop after the first element")
+}
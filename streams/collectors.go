@@ -0,0 +1,66 @@
+package streams
+
+import (
+	"github.com/jorge-barroso/collections/lists"
+	"github.com/jorge-barroso/collections/maps"
+)
+
+// Collector describes how to accumulate the values of a stream into a container of type R
+type Collector[T, R any] struct {
+	supplier    func() R   // Creates the empty container
+	accumulator func(R, T) // Adds one value to the container
+}
+
+// NewCollector creates a Collector from a function creating the container and one adding values to it
+func NewCollector[T, R any](supplier func() R, accumulator func(R, T)) Collector[T, R] {
+	return Collector[T, R]{
+		supplier:    supplier,
+		accumulator: accumulator,
+	}
+}
+
+// Collect runs the stream and accumulates its values with the given collector
+func Collect[T, R any](s *Stream[T], collector Collector[T, R]) R {
+	container := collector.supplier()
+	for value := range s.seq {
+		collector.accumulator(container, value)
+	}
+	return container
+}
+
+// ToArrayList collects the values into a new ArrayList, in encounter order
+func ToArrayList[T any]() Collector[T, *lists.ArrayList[T]] {
+	return NewCollector(lists.NewArrayList[T], func(list *lists.ArrayList[T], value T) {
+		list.Add(value)
+	})
+}
+
+// ToLinkedHashMap collects the values into a new LinkedHashMap keyed by key, later values
+// replace earlier ones with the same key
+func ToLinkedHashMap[T any, K comparable, V any](key func(T) K, value func(T) V) Collector[T, *maps.LinkedHashMap[K, V]] {
+	return NewCollector(maps.NewLinkedHashMap[K, V], func(m *maps.LinkedHashMap[K, V], item T) {
+		m.Put(key(item), value(item))
+	})
+}
+
+// ToTreeMap collects the values into a new TreeMap keyed by key and sorted with less,
+// later values replace earlier ones with the same key
+func ToTreeMap[T any, K comparable, V any](key func(T) K, value func(T) V, less func(a, b K) bool) Collector[T, *maps.TreeMap[K, V]] {
+	return NewCollector(func() *maps.TreeMap[K, V] { return maps.NewTreeMap[K, V](less) }, func(m *maps.TreeMap[K, V], item T) {
+		m.Put(key(item), value(item))
+	})
+}
+
+// GroupingBy collects the values into lists grouped by key.
+// Groups appear in the order their first value was encountered.
+func GroupingBy[T any, K comparable](key func(T) K) Collector[T, *maps.LinkedHashMap[K, *lists.ArrayList[T]]] {
+	return NewCollector(maps.NewLinkedHashMap[K, *lists.ArrayList[T]], func(m *maps.LinkedHashMap[K, *lists.ArrayList[T]], item T) {
+		k := key(item)
+		group, err := m.Get(k)
+		if err != nil {
+			group = lists.NewArrayList[T]()
+			m.Put(k, group)
+		}
+		group.Add(item)
+	})
+}
@@ -0,0 +1,62 @@
+package streams
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollect_ToArrayList(t *testing.T) {
+	list := Collect(Of(3, 1, 2), ToArrayList[int]())
+	assert.Equal(t, []int{3, 1, 2}, slices.Collect(list.All()), "ArrayList content mismatch")
+}
+
+func TestCollect_ToLinkedHashMap(t *testing.T) {
+	m := Collect(Of("apple", "bob", "avocado"), ToLinkedHashMap(
+		func(s string) byte { return s[0] },
+		strings.ToUpper,
+	))
+
+	assert.Equal(t, []byte{'a', 'b'}, slices.Collect(m.Keys()), "Keys should follow the encounter order")
+	value, err := m.Get('a')
+	assert.NoError(t, err, "Unexpected error when getting key 'a'")
+	assert.Equal(t, "AVOCADO", value, "Later values should replace earlier ones")
+}
+
+func TestCollect_ToTreeMap(t *testing.T) {
+	m := Collect(Of("ccc", "a", "bb"), ToTreeMap(
+		func(s string) int { return len(s) },
+		func(s string) string { return s },
+		func(a, b int) bool { return a < b },
+	))
+
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(m.Keys()), "Keys should be sorted")
+	assert.Equal(t, []string{"a", "bb", "ccc"}, slices.Collect(m.Values()), "Values should follow the key order")
+}
+
+func TestCollect_GroupingBy(t *testing.T) {
+	groups := Collect(Of(1, 2, 3, 4, 5, 6), GroupingBy(func(v int) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}))
+
+	assert.Equal(t, []string{"odd", "even"}, slices.Collect(groups.Keys()), "Groups should follow the order they were first seen")
+	odd, err := groups.Get("odd")
+	assert.NoError(t, err, "Unexpected error when getting the odd group")
+	assert.Equal(t, []int{1, 3, 5}, slices.Collect(odd.All()), "Odd group mismatch")
+	even, err := groups.Get("even")
+	assert.NoError(t, err, "Unexpected error when getting the even group")
+	assert.Equal(t, []int{2, 4, 6}, slices.Collect(even.All()), "Even group mismatch")
+}
+
+func TestCollect_CustomCollector(t *testing.T) {
+	joined := Collect(Of("a", "b", "c"), NewCollector(
+		func() *strings.Builder { return &strings.Builder{} },
+		func(b *strings.Builder, s string) { b.WriteString(s) },
+	))
+	assert.Equal(t, "abc", joined.String(), "Custom collector mismatch")
+}
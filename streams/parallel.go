@@ -0,0 +1,124 @@
+package streams
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/jorge-barroso/collections"
+)
+
+// chunksPerWorker controls how finely the source is split, a few chunks per worker
+// smooths out uneven chunk sizes without paying for too many goroutine hand-offs
+const chunksPerWorker = 4
+
+// ParallelForEach calls action on every element of the spliterator using at most workers
+// goroutines, a value of zero or less uses one worker per available CPU.
+// action is called concurrently and must be safe for concurrent use.
+func ParallelForEach[T any](source collections.Spliterator[T], workers int, action func(T)) {
+	chunks := splitChunks(source, workers)
+	runChunks(chunks, workers, func(_ int, chunk collections.Spliterator[T]) {
+		chunk.ForEachRemaining(action)
+	})
+}
+
+// ParallelMap transforms every element of the spliterator using at most workers goroutines,
+// a value of zero or less uses one worker per available CPU.
+// The results are returned in the encounter order of the spliterator.
+func ParallelMap[T, R any](source collections.Spliterator[T], workers int, mapper func(T) R) []R {
+	chunks := splitChunks(source, workers)
+	results := make([][]R, len(chunks))
+	runChunks(chunks, workers, func(i int, chunk collections.Spliterator[T]) {
+		mapped := make([]R, 0, chunk.EstimateSize())
+		chunk.ForEachRemaining(func(value T) {
+			mapped = append(mapped, mapper(value))
+		})
+		results[i] = mapped
+	})
+
+	total := 0
+	for _, mapped := range results {
+		total += len(mapped)
+	}
+	flattened := make([]R, 0, total)
+	for _, mapped := range results {
+		flattened = append(flattened, mapped...)
+	}
+	return flattened
+}
+
+// ParallelReduce folds every element of the spliterator using at most workers goroutines,
+// a value of zero or less uses one worker per available CPU.
+// Each chunk is folded with accumulator starting from identity, and the partial results are
+// then merged with combiner in encounter order, so the result is deterministic as long as
+// combiner is associative and identity is neutral for it.
+func ParallelReduce[T, R any](source collections.Spliterator[T], workers int, identity R, accumulator func(R, T) R, combiner func(R, R) R) R {
+	chunks := splitChunks(source, workers)
+	partials := make([]R, len(chunks))
+	runChunks(chunks, workers, func(i int, chunk collections.Spliterator[T]) {
+		partial := identity
+		chunk.ForEachRemaining(func(value T) {
+			partial = accumulator(partial, value)
+		})
+		partials[i] = partial
+	})
+
+	result := identity
+	for _, partial := range partials {
+		result = combiner(result, partial)
+	}
+	return result
+}
+
+// workerCount normalises the requested number of workers
+func workerCount(workers int) int {
+	if workers <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return workers
+}
+
+// splitChunks splits source into chunks small enough to keep every worker busy,
+// returning them in encounter order
+func splitChunks[T any](source collections.Spliterator[T], workers int) []collections.Spliterator[T] {
+	threshold := source.EstimateSize() / (workerCount(workers) * chunksPerWorker)
+	if threshold < 1 {
+		threshold = 1
+	}
+
+	var chunks []collections.Spliterator[T]
+	var split func(s collections.Spliterator[T])
+	split = func(s collections.Spliterator[T]) {
+		for s.EstimateSize() > threshold {
+			prefix := s.TrySplit()
+			if prefix == nil {
+				break
+			}
+			split(prefix)
+		}
+		chunks = append(chunks, s)
+	}
+	split(source)
+	return chunks
+}
+
+// runChunks processes every chunk on a bounded pool of goroutines and waits for all of them
+func runChunks[T any](chunks []collections.Spliterator[T], workers int, process func(int, collections.Spliterator[T])) {
+	indices := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < min(workerCount(workers), len(chunks)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				process(i, chunks[i])
+			}
+		}()
+	}
+
+	for i := range chunks {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+}
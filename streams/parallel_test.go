@@ -0,0 +1,72 @@
+package streams
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/jorge-barroso/collections/lists"
+	"github.com/jorge-barroso/collections/maps"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallelForEach(t *testing.T) {
+	list := lists.NewArrayList[int]()
+	for i := 1; i <= 1000; i++ {
+		list.Add(i)
+	}
+
+	var sum atomic.Int64
+	ParallelForEach(list.Spliterator(), 4, func(v int) { sum.Add(int64(v)) })
+	assert.Equal(t, int64(500500), sum.Load(), "Every element should be visited exactly once")
+}
+
+func TestParallelMap_PreservesOrder(t *testing.T) {
+	list := lists.NewArrayList[int]()
+	var want []int
+	for i := 0; i < 1000; i++ {
+		list.Add(i)
+		want = append(want, i*i)
+	}
+
+	for _, workers := range []int{0, 1, 3, 16} {
+		got := ParallelMap(list.Spliterator(), workers, func(v int) int { return v * v })
+		assert.Equal(t, want, got, "Results should follow the encounter order with %d workers", workers)
+	}
+}
+
+func TestParallelReduce_Deterministic(t *testing.T) {
+	tm := maps.NewTreeMap[int, string](func(a, b int) bool { return a < b })
+	expected := ""
+	for i := 0; i < 26; i++ {
+		letter := string(rune('a' + i))
+		tm.Put(i, letter)
+		expected += letter
+	}
+
+	// Concatenation is associative but not commutative, so any reordering would show
+	for _, workers := range []int{1, 2, 8} {
+		got := ParallelReduce(tm.Spliterator(), workers, "",
+			func(acc string, e maps.Entry[int, string]) string { return acc + e.Value() },
+			func(a, b string) string { return a + b },
+		)
+		assert.Equal(t, expected, got, "Reduction mismatch with %d workers", workers)
+	}
+}
+
+func TestParallelReduce_ConcurrentHashMap(t *testing.T) {
+	cm := maps.NewConcurrentHashMap[int, int]()
+	for i := 1; i <= 100; i++ {
+		cm.Put(i, i)
+	}
+
+	sum := ParallelReduce(cm.Spliterator(), 4, 0,
+		func(acc int, e maps.Entry[int, int]) int { return acc + e.Value() },
+		func(a, b int) int { return a + b },
+	)
+	assert.Equal(t, 5050, sum, "Sum mismatch over a ConcurrentHashMap")
+}
+
+func TestParallelMap_Empty(t *testing.T) {
+	got := ParallelMap(lists.NewArrayList[int]().Spliterator(), 4, func(v int) int { return v })
+	assert.Empty(t, got, "Mapping an empty source should produce no results")
+}
@@ -0,0 +1,182 @@
+// Package streams provides lazy pipelines over collections.Iterable values and iter.Seq sequences,
+// in the spirit of Java streams.
+package streams
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/jorge-barroso/collections"
+)
+
+// Stream is a lazy pipeline of values.
+// Intermediate operations only describe the pipeline, nothing is evaluated until a terminal
+// operation such as Reduce, Count or Collect runs. A stream can be consumed more than once
+// as long as its source can be iterated more than once.
+type Stream[T any] struct {
+	seq iter.Seq[T]
+}
+
+// Pair holds the values of two streams combined by Zip
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Of creates a new Stream over the given values
+func Of[T any](values ...T) *Stream[T] {
+	return FromSeq(slices.Values(values))
+}
+
+// FromIterable creates a new Stream over the elements of any collection
+func FromIterable[T any](iterable collections.Iterable[T]) *Stream[T] {
+	return FromSeq(collections.ToSeq(iterable))
+}
+
+// FromSeq creates a new Stream over an iter.Seq
+func FromSeq[T any](seq iter.Seq[T]) *Stream[T] {
+	return &Stream[T]{seq: seq}
+}
+
+// Seq returns the stream as an iter.Seq so it can be used with range-over-func
+func (s *Stream[T]) Seq() iter.Seq[T] {
+	return s.seq
+}
+
+// Filter keeps only the values matching the predicate
+func (s *Stream[T]) Filter(predicate func(T) bool) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for value := range s.seq {
+			if predicate(value) && !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Sorted sorts the values with the given comparison function, keeping equal values in encounter order.
+// The whole upstream has to be consumed before the first value is emitted.
+func (s *Stream[T]) Sorted(cmp func(a, b T) int) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		values := slices.Collect(s.seq)
+		slices.SortStableFunc(values, cmp)
+		for _, value := range values {
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Limit truncates the stream to at most n values
+func (s *Stream[T]) Limit(n int) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		count := 0
+		for value := range s.seq {
+			if !yield(value) {
+				return
+			}
+			count++
+			if count >= n {
+				return
+			}
+		}
+	})
+}
+
+// Skip discards the first n values
+func (s *Stream[T]) Skip(n int) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		skipped := 0
+		for value := range s.seq {
+			if skipped < n {
+				skipped++
+				continue
+			}
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// TakeWhile emits values until the first one not matching the predicate
+func (s *Stream[T]) TakeWhile(predicate func(T) bool) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for value := range s.seq {
+			if !predicate(value) || !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Peek calls action on every value as it flows through the pipeline, mainly useful for debugging
+func (s *Stream[T]) Peek(action func(T)) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for value := range s.seq {
+			action(value)
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Map transforms every value of the stream
+func Map[T, R any](s *Stream[T], mapper func(T) R) *Stream[R] {
+	return FromSeq(func(yield func(R) bool) {
+		for value := range s.seq {
+			if !yield(mapper(value)) {
+				return
+			}
+		}
+	})
+}
+
+// FlatMap replaces every value of the stream with the values of the stream returned by mapper
+func FlatMap[T, R any](s *Stream[T], mapper func(T) *Stream[R]) *Stream[R] {
+	return FromSeq(func(yield func(R) bool) {
+		for value := range s.seq {
+			for inner := range mapper(value).seq {
+				if !yield(inner) {
+					return
+				}
+			}
+		}
+	})
+}
+
+// Distinct drops values that have already been emitted
+func Distinct[T comparable](s *Stream[T]) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		seen := make(map[T]struct{})
+		for value := range s.seq {
+			if _, ok := seen[value]; ok {
+				continue
+			}
+			seen[value] = struct{}{}
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Zip pairs up the values of two streams, stopping as soon as either of them runs out
+func Zip[A, B any](first *Stream[A], second *Stream[B]) *Stream[Pair[A, B]] {
+	return FromSeq(func(yield func(Pair[A, B]) bool) {
+		next, stop := iter.Pull(second.seq)
+		defer stop()
+
+		for a := range first.seq {
+			b, ok := next()
+			if !ok || !yield(Pair[A, B]{First: a, Second: b}) {
+				return
+			}
+		}
+	})
+}
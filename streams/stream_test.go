@@ -0,0 +1,90 @@
+package streams
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/jorge-barroso/collections/lists"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStream_FromIterable(t *testing.T) {
+	list := lists.NewArrayList[int]()
+	for i := 1; i <= 5; i++ {
+		list.Add(i)
+	}
+
+	result := FromIterable[int](list).
+		Filter(func(v int) bool { return v%2 == 1 }).
+		ToSlice()
+	assert.Equal(t, []int{1, 3, 5}, result, "Filter mismatch over an ArrayList")
+}
+
+func TestStream_IsLazy(t *testing.T) {
+	var peeked []int
+	s := Of(1, 2, 3, 4, 5).
+		Peek(func(v int) { peeked = append(peeked, v) }).
+		Filter(func(v int) bool { return v > 1 }).
+		Limit(2)
+	assert.Empty(t, peeked, "No value should be evaluated before a terminal operation")
+
+	assert.Equal(t, []int{2, 3}, s.ToSlice(), "Limit mismatch")
+	assert.Equal(t, []int{1, 2, 3}, peeked, "Only the values needed by the terminal operation should be evaluated")
+}
+
+func TestStream_IntermediateOperations(t *testing.T) {
+	assert.Equal(t, []int{3, 4, 5}, Of(1, 2, 3, 4, 5).Skip(2).ToSlice(), "Skip mismatch")
+	assert.Empty(t, Of(1, 2, 3).Limit(0).ToSlice(), "Limit(0) should produce an empty stream")
+	assert.Equal(t, []int{1, 2}, Of(1, 2, 5, 1).TakeWhile(func(v int) bool { return v < 3 }).ToSlice(), "TakeWhile mismatch")
+	assert.Equal(t, []int{3, 1, 2}, Distinct(Of(3, 1, 3, 2, 1)).ToSlice(), "Distinct should keep the first occurrence")
+
+	type person struct {
+		name string
+		age  int
+	}
+	people := Of(person{"ann", 30}, person{"bob", 25}, person{"cid", 30}, person{"dan", 25})
+	sorted := Map(people.Sorted(func(a, b person) int { return cmp.Compare(a.age, b.age) }), func(p person) string { return p.name })
+	assert.Equal(t, []string{"bob", "dan", "ann", "cid"}, sorted.ToSlice(), "Sorted should be stable")
+}
+
+func TestStream_MapFlatMapZip(t *testing.T) {
+	mapped := Map(Of(1, 2, 3), strconv.Itoa)
+	assert.Equal(t, []string{"1", "2", "3"}, mapped.ToSlice(), "Map mismatch")
+
+	flat := FlatMap(Of(1, 2, 3), func(v int) *Stream[int] { return Of(slices.Repeat([]int{v}, v)...) })
+	assert.Equal(t, []int{1, 2, 2, 3, 3, 3}, flat.ToSlice(), "FlatMap mismatch")
+
+	zipped := Zip(Of(1, 2, 3), Of("a", "b")).ToSlice()
+	assert.Equal(t, []Pair[int, string]{{1, "a"}, {2, "b"}}, zipped, "Zip should stop at the shorter stream")
+}
+
+func TestStream_TerminalOperations(t *testing.T) {
+	s := Of(4, 1, 3, 1, 5)
+
+	assert.Equal(t, 14, s.Reduce(0, func(a, b int) int { return a + b }), "Reduce mismatch")
+	assert.Equal(t, "41315", Fold(s, "", func(acc string, v int) string { return acc + strconv.Itoa(v) }), "Fold mismatch")
+	assert.Equal(t, 5, s.Count(), "Count mismatch")
+
+	minimum, ok := s.Min(cmp.Compare[int])
+	assert.True(t, ok, "Min should find a value in a non-empty stream")
+	assert.Equal(t, 1, minimum, "Min mismatch")
+	maximum, ok := s.Max(cmp.Compare[int])
+	assert.True(t, ok, "Max should find a value in a non-empty stream")
+	assert.Equal(t, 5, maximum, "Max mismatch")
+	_, ok = Of[int]().Min(cmp.Compare[int])
+	assert.False(t, ok, "Min should not find a value in an empty stream")
+
+	first, ok := s.FindFirst()
+	assert.True(t, ok, "FindFirst should find a value in a non-empty stream")
+	assert.Equal(t, 4, first, "FindFirst mismatch")
+
+	assert.True(t, s.AnyMatch(func(v int) bool { return v == 3 }), "AnyMatch should find 3")
+	assert.False(t, s.AllMatch(func(v int) bool { return v > 1 }), "AllMatch should fail on 1")
+	assert.True(t, s.NoneMatch(func(v int) bool { return v > 5 }), "NoneMatch should hold for values above 5")
+
+	var seen []int
+	s.ForEach(func(v int) { seen = append(seen, v) })
+	assert.Equal(t, []int{4, 1, 3, 1, 5}, seen, "ForEach should visit every value in order")
+}
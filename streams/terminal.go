@@ -0,0 +1,101 @@
+package streams
+
+import "slices"
+
+// ForEach calls action on every value of the stream
+func (s *Stream[T]) ForEach(action func(T)) {
+	for value := range s.seq {
+		action(value)
+	}
+}
+
+// Reduce combines every value of the stream into one, starting from identity
+func (s *Stream[T]) Reduce(identity T, accumulator func(T, T) T) T {
+	result := identity
+	for value := range s.seq {
+		result = accumulator(result, value)
+	}
+	return result
+}
+
+// Count returns the number of values in the stream
+func (s *Stream[T]) Count() int {
+	count := 0
+	for range s.seq {
+		count++
+	}
+	return count
+}
+
+// Min returns the smallest value according to cmp, or false if the stream is empty.
+// When several values are equally small the first one is returned.
+func (s *Stream[T]) Min(cmp func(a, b T) int) (T, bool) {
+	return s.best(func(candidate, best T) bool { return cmp(candidate, best) < 0 })
+}
+
+// Max returns the largest value according to cmp, or false if the stream is empty.
+// When several values are equally large the first one is returned.
+func (s *Stream[T]) Max(cmp func(a, b T) int) (T, bool) {
+	return s.best(func(candidate, best T) bool { return cmp(candidate, best) > 0 })
+}
+
+// best returns the value that beats every other one, keeping the first on ties
+func (s *Stream[T]) best(beats func(candidate, best T) bool) (T, bool) {
+	var result T
+	found := false
+	for value := range s.seq {
+		if !found || beats(value, result) {
+			result = value
+			found = true
+		}
+	}
+	return result, found
+}
+
+// FindFirst returns the first value of the stream, or false if the stream is empty
+func (s *Stream[T]) FindFirst() (T, bool) {
+	for value := range s.seq {
+		return value, true
+	}
+	var zero T
+	return zero, false
+}
+
+// AnyMatch reports whether any value matches the predicate, stopping at the first match
+func (s *Stream[T]) AnyMatch(predicate func(T) bool) bool {
+	for value := range s.seq {
+		if predicate(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllMatch reports whether every value matches the predicate, stopping at the first mismatch
+func (s *Stream[T]) AllMatch(predicate func(T) bool) bool {
+	for value := range s.seq {
+		if !predicate(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// NoneMatch reports whether no value matches the predicate, stopping at the first match
+func (s *Stream[T]) NoneMatch(predicate func(T) bool) bool {
+	return !s.AnyMatch(predicate)
+}
+
+// ToSlice collects the values of the stream into a new slice
+func (s *Stream[T]) ToSlice() []T {
+	return slices.Collect(s.seq)
+}
+
+// Fold combines every value of the stream into a result of a different type, starting from identity
+func Fold[T, R any](s *Stream[T], identity R, accumulator func(R, T) R) R {
+	result := identity
+	for value := range s.seq {
+		result = accumulator(result, value)
+	}
+	return result
+}